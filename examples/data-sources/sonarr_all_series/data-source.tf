@@ -1,2 +1,7 @@
 data "sonarr_all_series" "example" {
 }
+
+data "sonarr_all_series" "tagged" {
+  tags  = [1, 2]
+  match = "any"
+}
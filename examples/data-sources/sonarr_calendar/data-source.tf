@@ -0,0 +1,5 @@
+data "sonarr_calendar" "example" {
+  start       = "2024-01-01T00:00:00Z"
+  end         = "2024-01-08T00:00:00Z"
+  unmonitored = true
+}
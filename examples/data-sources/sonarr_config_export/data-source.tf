@@ -0,0 +1,7 @@
+data "sonarr_config_export" "example" {
+}
+
+resource "local_file" "backup" {
+  content  = data.sonarr_config_export.example.json
+  filename = "${path.module}/sonarr.json"
+}
@@ -0,0 +1,3 @@
+data "sonarr_cutoff_unmet" "example" {
+  page_size = 50
+}
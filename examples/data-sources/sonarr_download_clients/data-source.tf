@@ -1,2 +1,7 @@
 data "sonarr_download_clients" "example" {
 }
+
+data "sonarr_download_clients" "usenet" {
+  enabled  = true
+  protocol = "usenet"
+}
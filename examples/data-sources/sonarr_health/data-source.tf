@@ -0,0 +1,3 @@
+data "sonarr_health" "example" {
+  fail_on = "error"
+}
@@ -0,0 +1,5 @@
+data "sonarr_history" "example" {
+  since = "2024-01-01T00:00:00Z"
+  until = "2024-02-01T00:00:00Z"
+  limit = 50
+}
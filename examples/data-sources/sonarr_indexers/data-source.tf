@@ -1,2 +1,6 @@
 data "sonarr_indexers" "example" {
 }
+
+data "sonarr_indexers" "torrent" {
+  protocol = "torrent"
+}
@@ -0,0 +1,3 @@
+data "sonarr_language_profile" "example" {
+  name = "English"
+}
@@ -0,0 +1,3 @@
+data "sonarr_manual_import" "example" {
+  folder = "/downloads/complete"
+}
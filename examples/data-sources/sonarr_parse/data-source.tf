@@ -0,0 +1,3 @@
+data "sonarr_parse" "example" {
+  title = "Breaking.Bad.S01E02.1080p.WEB-DL.DD5.1.H.264-GROUP"
+}
@@ -0,0 +1,3 @@
+data "sonarr_ping" "example" {
+  fail_on_unreachable = true
+}
@@ -0,0 +1,3 @@
+data "sonarr_queue" "example" {
+  status = ["downloading", "queued"]
+}
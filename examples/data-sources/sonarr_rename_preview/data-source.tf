@@ -0,0 +1,4 @@
+data "sonarr_rename_preview" "example" {
+  series_id     = 1
+  season_number = 1
+}
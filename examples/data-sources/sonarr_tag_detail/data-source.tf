@@ -0,0 +1,3 @@
+data "sonarr_tag_detail" "example" {
+  id = 1
+}
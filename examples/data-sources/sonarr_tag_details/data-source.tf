@@ -0,0 +1,2 @@
+data "sonarr_tag_details" "example" {
+}
@@ -0,0 +1,3 @@
+data "sonarr_unmapped_folders" "example" {
+  root_folder_id = 1
+}
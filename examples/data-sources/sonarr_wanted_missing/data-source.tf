@@ -0,0 +1,3 @@
+data "sonarr_wanted_missing" "example" {
+  page_size = 50
+}
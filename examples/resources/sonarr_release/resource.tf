@@ -0,0 +1,9 @@
+data "sonarr_release" "example" {
+  series_id     = 1
+  season_number = 1
+}
+
+resource "sonarr_release" "example" {
+  guid       = data.sonarr_release.example.releases[0].guid
+  indexer_id = data.sonarr_release.example.releases[0].indexer_id
+}
@@ -6,9 +6,32 @@ resource "sonarr_series" "example" {
   monitored           = true
   season_folder       = true
   use_scene_numbering = false
-  path                = "/tmp/breaking_bad"
   root_folder_path    = "/tmp/"
 
   quality_profile_id = 1
   tags               = [1]
+
+  add_options = {
+    monitor = "latestSeason"
+  }
+}
+
+# Sonarr v3 requires a language profile
+data "sonarr_language_profile" "english" {
+  name = "English"
+}
+
+resource "sonarr_series" "v3_example" {
+  title      = "The Wire"
+  title_slug = "the-wire"
+  tvdb_id    = 79126
+
+  monitored           = true
+  season_folder       = true
+  use_scene_numbering = false
+  path                = "/tmp/the_wire"
+  root_folder_path    = "/tmp/"
+
+  quality_profile_id  = 1
+  language_profile_id = data.sonarr_language_profile.english.id
 }
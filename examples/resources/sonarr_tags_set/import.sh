@@ -0,0 +1,2 @@
+# import the listed existing tags, they are adopted and not deleted on destroy
+terraform import sonarr_tags_set.example "anime,kids,4k"
@@ -0,0 +1,3 @@
+resource "sonarr_tags_set" "example" {
+  labels = ["anime", "kids", "4k"]
+}
@@ -1,7 +1,9 @@
 package helpers
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 )
@@ -13,9 +15,12 @@ const (
 	Update                            = "update"
 	Delete                            = "delete"
 	List                              = "list"
+	TestAction                        = "test"
 	ClientError                       = "Client Error"
 	ResourceError                     = "Resource Error"
+	ResourceWarning                   = "Resource Warning"
 	DataSourceError                   = "Data Source Error"
+	ValidationError                   = "Validation Error"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
 	UnexpectedResourceConfigureType   = "Unexpected Resource Configure Type"
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
@@ -36,3 +41,39 @@ func ParseClientError(action, name string, err error) string {
 
 	return fmt.Sprintf("Unable to %s %s, got error: %s", action, name, err)
 }
+
+// validationFailure is a single entry of a Sonarr validation error body.
+type validationFailure struct {
+	PropertyName string `json:"propertyName"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// ParseValidationError returns the Sonarr validation messages of property, if err is a validation failure on it.
+func ParseValidationError(err error, property string) (string, bool) {
+	if e, ok := err.(*sonarr.GenericOpenAPIError); ok {
+		return parseValidationBody(e.Body(), property)
+	}
+
+	return "", false
+}
+
+func parseValidationBody(body []byte, property string) (string, bool) {
+	var failures []validationFailure
+	if err := json.Unmarshal(body, &failures); err != nil {
+		return "", false
+	}
+
+	messages := make([]string, 0, len(failures))
+
+	for _, f := range failures {
+		if strings.EqualFold(f.PropertyName, property) {
+			messages = append(messages, f.ErrorMessage)
+		}
+	}
+
+	if len(messages) == 0 {
+		return "", false
+	}
+
+	return strings.Join(messages, "\n"), true
+}
@@ -40,6 +40,48 @@ func TestParseClientError(t *testing.T) {
 	}
 }
 
+func TestParseValidationError(t *testing.T) {
+	t.Parallel()
+
+	message, ok := ParseValidationError(errors.New("other error"), "path")
+	assert.False(t, ok)
+	assert.Equal(t, "", message)
+
+	tests := map[string]struct {
+		body     string
+		message  string
+		expected bool
+	}{
+		"path": {
+			body:     `[{"propertyName":"Path","errorMessage":"File does not exist","severity":"error"}]`,
+			message:  "File does not exist",
+			expected: true,
+		},
+		"multiple": {
+			body:     `[{"propertyName":"Path","errorMessage":"Invalid path"},{"propertyName":"Name","errorMessage":"Should be unique"},{"propertyName":"path","errorMessage":"File does not exist"}]`,
+			message:  "Invalid path\nFile does not exist",
+			expected: true,
+		},
+		"other_property": {
+			body: `[{"propertyName":"Name","errorMessage":"Should be unique"}]`,
+		},
+		"not_validation": {
+			body: `{"message":"NotFound"}`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			message, ok := parseValidationBody([]byte(test.body), "path")
+			assert.Equal(t, test.expected, ok)
+			assert.Equal(t, test.message, message)
+		})
+	}
+}
+
 func TestParseNotFoundError(t *testing.T) {
 	t.Parallel()
 
@@ -564,6 +564,20 @@ func TestReadFields(t *testing.T) {
 	}
 }
 
+func TestReadFieldsUnset(t *testing.T) {
+	t.Parallel()
+
+	// unset seed criteria must not override the indexer defaults
+	fields := ReadFields(context.Background(), &Test{
+		SeedTime: types.Int64Null(),
+		Fl:       types.Float64Unknown(),
+	}, Fields{
+		Ints:   []string{"seedTime"},
+		Floats: []string{"fl"},
+	})
+	assert.Empty(t, fields)
+}
+
 func TestWriteFields(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,107 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UseStateForUnknownOrZero returns a plan modifier that copies a known prior state
+// value into the planned value, or plans zero when there is no prior state.
+// It is meant for optional computed integers that Sonarr stores as 0 when not configured,
+// avoiding unknown values and diffs when they are omitted.
+// extends https://github.com/hashicorp/terraform-plugin-framework/blob/main/resource/schema/int64planmodifier/use_state_for_unknown.go.
+func UseStateForUnknownOrZero() planmodifier.Int64 {
+	return useStateForUnknownOrZeroModifier{}
+}
+
+// useStateForUnknownOrZeroModifier implements the plan modifier.
+type useStateForUnknownOrZeroModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m useStateForUnknownOrZeroModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change. If not configured, it defaults to 0."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useStateForUnknownOrZeroModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyInt64 implements the plan modification logic.
+func (m useStateForUnknownOrZeroModifier) PlanModifyInt64(_ context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// Do nothing if there is a known planned value.
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Sonarr stores unset values as 0.
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		resp.PlanValue = types.Int64Value(0)
+
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// UseAliasValue returns a plan modifier that copies the configured value of
+// an alias attribute when this one is not configured.
+// It is meant for attributes renamed in Sonarr, keeping the old and new name in sync.
+func UseAliasValue(alias path.Path) planmodifier.Bool {
+	return useAliasValueModifier{alias: alias}
+}
+
+// useAliasValueModifier implements the plan modifier.
+type useAliasValueModifier struct {
+	alias path.Path
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m useAliasValueModifier) Description(_ context.Context) string {
+	return "If not configured, the value of this attribute is taken from " + m.alias.String() + "."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useAliasValueModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyBool implements the plan modification logic.
+func (m useAliasValueModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var alias types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, m.alias, &alias)...)
+
+	// Do nothing if the alias is not configured.
+	if resp.Diagnostics.HasError() || alias.IsNull() || alias.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = alias
+
+		return
+	}
+
+	if !req.ConfigValue.Equal(alias) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %s must be equal to %s when both are set.", req.Path, m.alias),
+		)
+	}
+}
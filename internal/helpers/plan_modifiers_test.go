@@ -0,0 +1,138 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUseStateForUnknownOrZero(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config   types.Int64
+		plan     types.Int64
+		state    types.Int64
+		expected types.Int64
+	}{
+		"configured": {
+			config:   types.Int64Value(2),
+			plan:     types.Int64Value(2),
+			state:    types.Int64Value(1),
+			expected: types.Int64Value(2),
+		},
+		"create": {
+			config:   types.Int64Null(),
+			plan:     types.Int64Unknown(),
+			state:    types.Int64Null(),
+			expected: types.Int64Value(0),
+		},
+		"update": {
+			config:   types.Int64Null(),
+			plan:     types.Int64Unknown(),
+			state:    types.Int64Value(3),
+			expected: types.Int64Value(3),
+		},
+		"unknown_config": {
+			config:   types.Int64Unknown(),
+			plan:     types.Int64Unknown(),
+			state:    types.Int64Value(3),
+			expected: types.Int64Unknown(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.Int64Request{
+				ConfigValue: test.config,
+				PlanValue:   test.plan,
+				StateValue:  test.state,
+			}
+			resp := &planmodifier.Int64Response{
+				PlanValue: test.plan,
+			}
+
+			UseStateForUnknownOrZero().PlanModifyInt64(context.Background(), req, resp)
+			assert.Equal(t, test.expected, resp.PlanValue)
+		})
+	}
+}
+
+func TestUseAliasValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config   types.Bool
+		alias    types.Bool
+		plan     types.Bool
+		expected types.Bool
+		err      bool
+	}{
+		"no_alias": {
+			config:   types.BoolValue(true),
+			alias:    types.BoolNull(),
+			plan:     types.BoolValue(true),
+			expected: types.BoolValue(true),
+		},
+		"alias_only": {
+			config:   types.BoolNull(),
+			alias:    types.BoolValue(true),
+			plan:     types.BoolUnknown(),
+			expected: types.BoolValue(true),
+		},
+		"same_value": {
+			config:   types.BoolValue(false),
+			alias:    types.BoolValue(false),
+			plan:     types.BoolValue(false),
+			expected: types.BoolValue(false),
+		},
+		"conflict": {
+			config:   types.BoolValue(false),
+			alias:    types.BoolValue(true),
+			plan:     types.BoolValue(false),
+			expected: types.BoolValue(false),
+			err:      true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			alias, _ := test.alias.ToTerraformValue(context.Background())
+
+			config := tfsdk.Config{
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"alias": schema.BoolAttribute{Optional: true},
+					},
+				},
+				Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"alias": tftypes.Bool}}, map[string]tftypes.Value{"alias": alias}),
+			}
+			req := planmodifier.BoolRequest{
+				Path:        path.Root("value"),
+				Config:      config,
+				ConfigValue: test.config,
+				PlanValue:   test.plan,
+			}
+			resp := &planmodifier.BoolResponse{
+				PlanValue: test.plan,
+			}
+
+			UseAliasValue(path.Root("alias")).PlanModifyBool(context.Background(), req, resp)
+			assert.Equal(t, test.expected, resp.PlanValue)
+			assert.Equal(t, test.err, resp.Diagnostics.HasError())
+		})
+	}
+}
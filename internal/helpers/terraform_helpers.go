@@ -2,8 +2,11 @@ package helpers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,12 +17,14 @@ import (
 // int value.
 // extends https://github.com/hashicorp/terraform-plugin-framework/blob/main/resource/import_state.go.
 func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.Atoi(req.ID)
+	id, err := ParseImportID(req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			UnexpectedImportIdentifier,
-			fmt.Sprintf("Expected import identifier with format: ID. Got: %s", req.ID),
+			fmt.Sprintf("Expected import identifier with format: ID. Got: %s, %s", req.ID, err),
 		)
+
+		return
 	}
 
 	if attrPath.Equal(path.Empty()) {
@@ -32,3 +37,52 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
+
+// ParseImportID parses a Sonarr ID used as import identifier.
+// Sonarr IDs are positive 32 bit integers, anything else is rejected.
+func ParseImportID(id string) (int64, error) {
+	value, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("ID must be an integer up to %d", math.MaxInt32)
+	}
+
+	if value <= 0 {
+		return 0, errors.New("ID must be greater than 0")
+	}
+
+	return value, nil
+}
+
+// ImportStatePassthroughIntIDOrName extends ImportStatePassthroughIntID
+// accepting also a name as import identifier, resolved through the list function.
+// Identifiers made of digits are always IDs, so that invalid ones report the ID error.
+func ImportStatePassthroughIntIDOrName(ctx context.Context, attrPath path.Path, kind string, req resource.ImportStateRequest, resp *resource.ImportStateResponse, list func() (map[string]int, error)) {
+	if isNumericID(req.ID) {
+		ImportStatePassthroughIntID(ctx, attrPath, req, resp)
+
+		return
+	}
+
+	names, err := list()
+	if err != nil {
+		resp.Diagnostics.AddError(ClientError, ParseClientError(List, kind, err))
+
+		return
+	}
+
+	id, ok := names[req.ID]
+	if !ok {
+		resp.Diagnostics.AddError(UnexpectedImportIdentifier, ParseNotFoundError(kind, "name", req.ID))
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
+}
+
+// isNumericID reports whether the identifier is made of digits, with an optional leading minus.
+func isNumericID(id string) bool {
+	digits := strings.TrimPrefix(id, "-")
+
+	return digits != "" && strings.Trim(digits, "0123456789") == ""
+}
@@ -0,0 +1,148 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImportID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		id       string
+		expected int64
+		err      bool
+	}{
+		"valid": {
+			id:       "42",
+			expected: 42,
+		},
+		"max": {
+			id:       "2147483647",
+			expected: 2147483647,
+		},
+		"negative": {
+			id:  "-1",
+			err: true,
+		},
+		"zero": {
+			id:  "0",
+			err: true,
+		},
+		"huge": {
+			id:  "2147483648",
+			err: true,
+		},
+		"non_numeric": {
+			id:  "abc",
+			err: true,
+		},
+		"empty": {
+			id:  "",
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := ParseImportID(test.id)
+			assert.Equal(t, test.expected, id)
+			assert.Equal(t, test.err, err != nil)
+		})
+	}
+}
+
+func TestImportStatePassthroughIntIDOrName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		id       string
+		err      string
+		detail   string
+		expected int64
+	}{
+		"id": {
+			id:       "42",
+			expected: 42,
+		},
+		"name": {
+			id:       "test",
+			expected: 7,
+		},
+		"unknown_name": {
+			id:     "missing",
+			err:    UnexpectedImportIdentifier,
+			detail: "Unable to find",
+		},
+		"negative": {
+			id:     "-1",
+			err:    UnexpectedImportIdentifier,
+			detail: "greater than 0",
+		},
+		"overflow": {
+			id:     "99999999999999999999",
+			err:    UnexpectedImportIdentifier,
+			detail: "integer up to",
+		},
+		"list_error": {
+			id:     "error",
+			err:    ClientError,
+			detail: "unavailable",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			attrSchema := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{Computed: true},
+				},
+			}
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{Schema: attrSchema, Raw: tftypes.NewValue(attrSchema.Type().TerraformType(ctx), nil)},
+			}
+
+			ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), "test", resource.ImportStateRequest{ID: test.id}, resp, func() (map[string]int, error) {
+				if test.id == "error" {
+					return nil, errors.New("unavailable")
+				}
+
+				if isNumericID(test.id) {
+					t.Errorf("unexpected name lookup for %s", test.id)
+				}
+
+				return map[string]int{"test": 7}, nil
+			})
+
+			if test.err != "" {
+				if assert.True(t, resp.Diagnostics.HasError()) {
+					assert.Equal(t, test.err, resp.Diagnostics.Errors()[0].Summary())
+					assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), test.detail)
+				}
+
+				return
+			}
+
+			var id int64
+
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, test.expected, id)
+		})
+	}
+}
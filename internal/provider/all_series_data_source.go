@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
+	"slices"
 	"strconv"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -30,7 +33,9 @@ type AllSeriessDataSource struct {
 // AllSeriess describes the series(es) data model.
 type SeriesList struct {
 	Series types.Set    `tfsdk:"series"`
+	Tags   types.Set    `tfsdk:"tags"`
 	ID     types.String `tfsdk:"id"`
+	Match  types.String `tfsdk:"match"`
 }
 
 func (d *AllSeriessDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,6 +50,18 @@ func (d *AllSeriessDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Return only the series with the given tags.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"match": schema.StringAttribute{
+				MarkdownDescription: "Tags matching mode. Valid values are 'all' (default) to return series with all the tags and 'any' to return series with at least one of them.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "any"),
+				},
+			},
 			"series": schema.SetNestedAttribute{
 				MarkdownDescription: "Series list.",
 				Computed:            true,
@@ -66,6 +83,18 @@ func (d *AllSeriessDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							MarkdownDescription: "Monitored flag.",
 							Computed:            true,
 						},
+						"monitor_new_items": schema.StringAttribute{
+							MarkdownDescription: "Monitor new items.",
+							Computed:            true,
+						},
+						"next_airing": schema.StringAttribute{
+							MarkdownDescription: "Next airing date in RFC3339 format, empty if none.",
+							Computed:            true,
+						},
+						"previous_airing": schema.StringAttribute{
+							MarkdownDescription: "Previous airing date in RFC3339 format, empty if none.",
+							Computed:            true,
+						},
 						"season_folder": schema.BoolAttribute{
 							MarkdownDescription: "Season Folder flag.",
 							Computed:            true,
@@ -78,6 +107,10 @@ func (d *AllSeriessDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							MarkdownDescription: "Quality Profile ID.",
 							Computed:            true,
 						},
+						"language_profile_id": schema.Int64Attribute{
+							MarkdownDescription: "Language Profile ID.",
+							Computed:            true,
+						},
 						"tvdb_id": schema.Int64Attribute{
 							MarkdownDescription: "TVDB ID.",
 							Computed:            true,
@@ -86,6 +119,18 @@ func (d *AllSeriessDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							MarkdownDescription: "Series Path.",
 							Computed:            true,
 						},
+						"folder_name": schema.StringAttribute{
+							MarkdownDescription: "Series folder name assigned by Sonarr.",
+							Computed:            true,
+						},
+						"poster_url": schema.StringAttribute{
+							MarkdownDescription: "Poster remote URL, empty if none.",
+							Computed:            true,
+						},
+						"fanart_url": schema.StringAttribute{
+							MarkdownDescription: "Fanart remote URL, empty if none.",
+							Computed:            true,
+						},
 						"root_folder_path": schema.StringAttribute{
 							MarkdownDescription: "Series Root Folder.",
 							Computed:            true,
@@ -95,6 +140,26 @@ func (d *AllSeriessDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"images": schema.SetNestedAttribute{
+							MarkdownDescription: "Series images.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"cover_type": schema.StringAttribute{
+										MarkdownDescription: "Cover type, such as 'poster', 'fanart' or 'banner'.",
+										Computed:            true,
+									},
+									"url": schema.StringAttribute{
+										MarkdownDescription: "Image URL on the Sonarr server.",
+										Computed:            true,
+									},
+									"remote_url": schema.StringAttribute{
+										MarkdownDescription: "Image remote URL.",
+										Computed:            true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -109,7 +174,15 @@ func (d *AllSeriessDataSource) Configure(ctx context.Context, req datasource.Con
 	}
 }
 
-func (d *AllSeriessDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *AllSeriessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *SeriesList
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get series current value
 	response, _, err := d.client.SeriesAPI.ListSeries(d.auth).Execute()
 	if err != nil {
@@ -119,13 +192,49 @@ func (d *AllSeriessDataSource) Read(ctx context.Context, _ datasource.ReadReques
 	}
 
 	tflog.Trace(ctx, "read "+allSeriesDataSourceName)
+
+	var tags []int32
+
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, true)...)
+
 	// Map response body to resource schema attribute
-	series := make([]Series, len(response))
-	for i, t := range response {
-		series[i].write(ctx, &t, &resp.Diagnostics)
+	series := make([]Series, 0, len(response))
+
+	for _, t := range response {
+		if !data.matchTags(t.GetTags(), tags) {
+			continue
+		}
+
+		var s Series
+
+		s.write(ctx, &t, &resp.Diagnostics)
+		series = append(series, s)
 	}
 
 	seriesList, diags := types.SetValueFrom(ctx, Series{}.getType(), series)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, SeriesList{Series: seriesList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+
+	data.Series = seriesList
+	data.ID = types.StringValue(strconv.Itoa(len(series)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// matchTags checks if the series tags match the filter, according to the match mode.
+func (s *SeriesList) matchTags(seriesTags, tags []int32) bool {
+	if len(tags) == 0 {
+		return true
+	}
+
+	for _, tag := range tags {
+		found := slices.Contains(seriesTags, tag)
+		if found && s.Match.ValueString() == "any" {
+			return true
+		}
+
+		if !found && s.Match.ValueString() != "any" {
+			return false
+		}
+	}
+
+	return s.Match.ValueString() != "any"
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -19,6 +20,12 @@ func TestAccAllSeriesDataSource(t *testing.T) {
 				Config:      testAccAllSeriesDataSourceConfig + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Invalid match mode
+			{
+				Config:      testAccAllSeriesDataSourceMatchConfig("some"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+				PlanOnly:    true,
+			},
 			// Create a resource to test
 			{
 				Config: testAccSeriesResourceConfig(332606, "Friends (2010)", "friends-2010", "false"),
@@ -30,6 +37,14 @@ func TestAccAllSeriesDataSource(t *testing.T) {
 					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_all_series.test", "series.*", map[string]string{"monitored": "false"}),
 				),
 			},
+			// Filter by tags
+			{
+				Config: testAccAllSeriesDataSourceMatchConfig("any"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_all_series.test", "series.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_all_series.test", "series.*", map[string]string{"title_slug": "the-office-us"}),
+				),
+			},
 		},
 	})
 }
@@ -38,3 +53,32 @@ const testAccAllSeriesDataSourceConfig = `
 data "sonarr_all_series" "test" {
 }
 `
+
+func testAccAllSeriesDataSourceMatchConfig(match string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_tag" "test" {
+		label = "allseriesdatasource"
+	}
+
+	resource "sonarr_series" "test" {
+		title      = "The Office (US)"
+		title_slug = "the-office-us"
+		tvdb_id    = 73244
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/the-office-us"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+		tags                = [sonarr_tag.test.id]
+	}
+
+	data "sonarr_all_series" "test" {
+		tags  = [sonarr_tag.test.id]
+		match = "%s"
+
+		depends_on = [sonarr_series.test]
+	}`, match)
+}
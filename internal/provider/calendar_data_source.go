@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const calendarDataSourceName = "calendar"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CalendarDataSource{}
+
+func NewCalendarDataSource() datasource.DataSource {
+	return &CalendarDataSource{}
+}
+
+// CalendarDataSource defines the calendar implementation.
+type CalendarDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// Calendar describes the calendar data model.
+type Calendar struct {
+	Episodes    types.List   `tfsdk:"episodes"`
+	ID          types.String `tfsdk:"id"`
+	Start       types.String `tfsdk:"start"`
+	End         types.String `tfsdk:"end"`
+	Unmonitored types.Bool   `tfsdk:"unmonitored"`
+}
+
+// CalendarEpisode is part of Calendar.
+type CalendarEpisode struct {
+	Title         types.String `tfsdk:"title"`
+	AirDateUtc    types.String `tfsdk:"air_date_utc"`
+	ID            types.Int64  `tfsdk:"id"`
+	SeriesID      types.Int64  `tfsdk:"series_id"`
+	SeasonNumber  types.Int64  `tfsdk:"season_number"`
+	EpisodeNumber types.Int64  `tfsdk:"episode_number"`
+	HasFile       types.Bool   `tfsdk:"has_file"`
+	Monitored     types.Bool   `tfsdk:"monitored"`
+}
+
+func (e CalendarEpisode) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"id":             types.Int64Type,
+			"series_id":      types.Int64Type,
+			"season_number":  types.Int64Type,
+			"episode_number": types.Int64Type,
+			"title":          types.StringType,
+			"air_date_utc":   types.StringType,
+			"has_file":       types.BoolType,
+			"monitored":      types.BoolType,
+		})
+}
+
+func (d *CalendarDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + calendarDataSourceName
+}
+
+func (d *CalendarDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nList the episodes airing in a date window.\nFor more information refer to [Calendar](https://wiki.servarr.com/sonarr/calendar) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "Window start date in RFC3339 format. Defaults to today.",
+				Optional:            true,
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "Window end date in RFC3339 format, must be after `start`. Defaults to two days after today.",
+				Optional:            true,
+			},
+			"unmonitored": schema.BoolAttribute{
+				MarkdownDescription: "Include unmonitored episodes. Defaults to `false`.",
+				Optional:            true,
+			},
+			"episodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Episodes airing in the window.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Episode ID.",
+							Computed:            true,
+						},
+						"series_id": schema.Int64Attribute{
+							MarkdownDescription: "Series ID.",
+							Computed:            true,
+						},
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Season number.",
+							Computed:            true,
+						},
+						"episode_number": schema.Int64Attribute{
+							MarkdownDescription: "Episode number.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Episode title.",
+							Computed:            true,
+						},
+						"air_date_utc": schema.StringAttribute{
+							MarkdownDescription: "Air date in RFC3339 format.",
+							Computed:            true,
+						},
+						"has_file": schema.BoolAttribute{
+							MarkdownDescription: "Episode file downloaded flag.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CalendarDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CalendarDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Calendar
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start := parseDate(data.Start, "start", &resp.Diagnostics)
+	end := parseDate(data.End, "end", &resp.Diagnostics)
+
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		resp.Diagnostics.AddAttributeError(path.Root("end"), helpers.DataSourceError, "'end' must be after 'start'")
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get calendar in the given window
+	request := d.client.CalendarAPI.ListCalendar(d.auth).Unmonitored(data.Unmonitored.ValueBool())
+	if !start.IsZero() {
+		request = request.Start(start)
+	}
+
+	if !end.IsZero() {
+		request = request.End(end)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, calendarDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+calendarDataSourceName)
+	// Map response body to resource schema attribute
+	episodes := make([]CalendarEpisode, len(response))
+	for i, e := range response {
+		episodes[i].write(&e)
+	}
+
+	episodeList, diags := types.ListValueFrom(ctx, CalendarEpisode{}.getType(), episodes)
+	resp.Diagnostics.Append(diags...)
+
+	data.Episodes = episodeList
+	data.ID = types.StringValue(strconv.Itoa(len(episodes)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (e *CalendarEpisode) write(episode *sonarr.EpisodeResource) {
+	e.ID = types.Int64Value(int64(episode.GetId()))
+	e.SeriesID = types.Int64Value(int64(episode.GetSeriesId()))
+	e.SeasonNumber = types.Int64Value(int64(episode.GetSeasonNumber()))
+	e.EpisodeNumber = types.Int64Value(int64(episode.GetEpisodeNumber()))
+	e.Title = types.StringValue(episode.GetTitle())
+	e.AirDateUtc = types.StringValue(episode.GetAirDateUtc().Format(time.RFC3339))
+	e.HasFile = types.BoolValue(episode.GetHasFile())
+	e.Monitored = types.BoolValue(episode.GetMonitored())
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCalendarDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccCalendarDataSourceConfig("2000-01-01T00:00:00Z", "2000-01-08T00:00:00Z") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid window
+			{
+				Config:      testAccCalendarDataSourceConfig("2000-01-08T00:00:00Z", "2000-01-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile("'end' must be after 'start'"),
+			},
+			// Read testing
+			{
+				Config: testAccCalendarDataSourceConfig("2000-01-01T00:00:00Z", "2000-01-08T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_calendar.test", "id"),
+					resource.TestCheckResourceAttrSet("data.sonarr_calendar.test", "episodes.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCalendarDataSourceConfig(start, end string) string {
+	return fmt.Sprintf(`
+	data "sonarr_calendar" "test" {
+		start = "%s"
+		end = "%s"
+		unmonitored = true
+	}`, start, end)
+}
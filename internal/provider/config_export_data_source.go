@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const configExportDataSourceName = "config_export"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConfigExportDataSource{}
+
+func NewConfigExportDataSource() datasource.DataSource {
+	return &ConfigExportDataSource{}
+}
+
+// ConfigExportDataSource defines the config export implementation.
+type ConfigExportDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// ConfigExport describes the config export data model.
+type ConfigExport struct {
+	ID   types.String `tfsdk:"id"`
+	JSON types.String `tfsdk:"json"`
+}
+
+// configExportContent is the JSON document exported by ConfigExport.
+type configExportContent struct {
+	Naming          *sonarr.NamingConfigResource    `json:"naming"`
+	Indexers        []sonarr.IndexerResource        `json:"indexers"`
+	DownloadClients []sonarr.DownloadClientResource `json:"download_clients"`
+	Notifications   []sonarr.NotificationResource   `json:"notifications"`
+	QualityProfiles []sonarr.QualityProfileResource `json:"quality_profiles"`
+}
+
+func (d *ConfigExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + configExportDataSourceName
+}
+
+func (d *ConfigExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nExport indexers, download clients, notifications, quality profiles and naming as a single JSON document, to diff or back up the configuration.\nSecrets are masked by Sonarr, so the export cannot be used to restore them.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Configuration as JSON, with `indexers`, `download_clients`, `notifications`, `quality_profiles` and `naming` keys.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (d *ConfigExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *ConfigExportDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var (
+		content configExportContent
+		err     error
+	)
+
+	// Get config export current value
+	if content.Indexers, _, err = d.client.IndexerAPI.ListIndexer(d.auth).Execute(); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexersDataSourceName, err))
+
+		return
+	}
+
+	if content.DownloadClients, _, err = d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute(); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientsDataSourceName, err))
+
+		return
+	}
+
+	if content.Notifications, _, err = d.client.NotificationAPI.ListNotification(d.auth).Execute(); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationsDataSourceName, err))
+
+		return
+	}
+
+	if content.QualityProfiles, _, err = d.client.QualityProfileAPI.ListQualityProfile(d.auth).Execute(); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfilesDataSourceName, err))
+
+		return
+	}
+
+	if content.Naming, _, err = d.client.NamingConfigAPI.GetNamingConfig(d.auth).Execute(); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingDataSourceName, err))
+
+		return
+	}
+
+	export, err := json.Marshal(content)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, "Unable to encode "+configExportDataSourceName+", got error: "+err.Error())
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+configExportDataSourceName)
+	// Map response body to resource schema attribute
+	data := ConfigExport{
+		ID:   types.StringValue(configExportDataSourceName),
+		JSON: types.StringValue(string(export)),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	cutoffUnmetDataSourceName = "cutoff_unmet"
+	cutoffUnmetPageSize       = 10
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CutoffUnmetDataSource{}
+
+func NewCutoffUnmetDataSource() datasource.DataSource {
+	return &CutoffUnmetDataSource{}
+}
+
+// CutoffUnmetDataSource defines the cutoff unmet implementation.
+type CutoffUnmetDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// CutoffUnmet describes the cutoff unmet data model.
+type CutoffUnmet struct {
+	Episodes     types.List   `tfsdk:"episodes"`
+	ID           types.String `tfsdk:"id"`
+	PageSize     types.Int64  `tfsdk:"page_size"`
+	TotalRecords types.Int64  `tfsdk:"total_records"`
+}
+
+// CutoffUnmetEpisode is part of CutoffUnmet.
+type CutoffUnmetEpisode struct {
+	SeriesTitle    types.String `tfsdk:"series_title"`
+	Title          types.String `tfsdk:"title"`
+	CurrentQuality types.String `tfsdk:"current_quality"`
+	CutoffQuality  types.String `tfsdk:"cutoff_quality"`
+	ID             types.Int64  `tfsdk:"id"`
+	SeriesID       types.Int64  `tfsdk:"series_id"`
+	SeasonNumber   types.Int64  `tfsdk:"season_number"`
+	EpisodeNumber  types.Int64  `tfsdk:"episode_number"`
+}
+
+func (e CutoffUnmetEpisode) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"id":              types.Int64Type,
+			"series_id":       types.Int64Type,
+			"season_number":   types.Int64Type,
+			"episode_number":  types.Int64Type,
+			"series_title":    types.StringType,
+			"title":           types.StringType,
+			"current_quality": types.StringType,
+			"cutoff_quality":  types.StringType,
+		})
+}
+
+func (d *CutoffUnmetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + cutoffUnmetDataSourceName
+}
+
+func (d *CutoffUnmetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nList the most recently aired monitored episodes with a quality below the profile cutoff.\nFor more information refer to [Wanted](https://wiki.servarr.com/sonarr/wanted) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of episodes. Defaults to `%d`.", cutoffUnmetPageSize),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"total_records": schema.Int64Attribute{
+				MarkdownDescription: "Total number of episodes below the cutoff.",
+				Computed:            true,
+			},
+			"episodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Cutoff unmet episodes sorted by air date descending.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Episode ID.",
+							Computed:            true,
+						},
+						"series_id": schema.Int64Attribute{
+							MarkdownDescription: "Series ID.",
+							Computed:            true,
+						},
+						"series_title": schema.StringAttribute{
+							MarkdownDescription: "Series title.",
+							Computed:            true,
+						},
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Season number.",
+							Computed:            true,
+						},
+						"episode_number": schema.Int64Attribute{
+							MarkdownDescription: "Episode number.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Episode title.",
+							Computed:            true,
+						},
+						"current_quality": schema.StringAttribute{
+							MarkdownDescription: "Quality of the episode file.",
+							Computed:            true,
+						},
+						"cutoff_quality": schema.StringAttribute{
+							MarkdownDescription: "Cutoff quality of the series quality profile.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CutoffUnmetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CutoffUnmetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *CutoffUnmet
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageSize := int32(cutoffUnmetPageSize)
+	if !data.PageSize.IsNull() {
+		pageSize = int32(data.PageSize.ValueInt64())
+	}
+
+	// Get cutoff unmet current value
+	response, _, err := d.client.CutoffAPI.GetWantedCutoff(d.auth).
+		PageSize(pageSize).
+		SortKey("episodes.airDateUtc").
+		SortDirection(sonarr.SORTDIRECTION_DESCENDING).
+		IncludeSeries(true).
+		IncludeEpisodeFile(true).
+		Monitored(true).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, cutoffUnmetDataSourceName, err))
+
+		return
+	}
+
+	profiles, _, err := d.client.QualityProfileAPI.ListQualityProfile(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, qualityProfileDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+cutoffUnmetDataSourceName)
+
+	// Map response body to resource schema attribute
+	cutoffs := make(map[int32]string, len(profiles))
+	for _, p := range profiles {
+		cutoffs[p.GetId()] = qualityProfileCutoffName(&p)
+	}
+
+	episodes := make([]CutoffUnmetEpisode, len(response.GetRecords()))
+	for i, e := range response.GetRecords() {
+		episodes[i].write(&e, cutoffs)
+	}
+
+	episodeList, diags := types.ListValueFrom(ctx, CutoffUnmetEpisode{}.getType(), episodes)
+	resp.Diagnostics.Append(diags...)
+
+	data.Episodes = episodeList
+	data.TotalRecords = types.Int64Value(int64(response.GetTotalRecords()))
+	data.ID = types.StringValue(strconv.Itoa(len(episodes)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// qualityProfileCutoffName returns the name of the quality or group used as cutoff.
+func qualityProfileCutoffName(profile *sonarr.QualityProfileResource) string {
+	for _, item := range profile.GetItems() {
+		quality := item.GetQuality()
+
+		if len(item.GetItems()) == 0 && quality.GetId() == profile.GetCutoff() {
+			return quality.GetName()
+		}
+
+		if len(item.GetItems()) > 0 && item.GetId() == profile.GetCutoff() {
+			return item.GetName()
+		}
+	}
+
+	return ""
+}
+
+func (e *CutoffUnmetEpisode) write(episode *sonarr.EpisodeResource, cutoffs map[int32]string) {
+	series := episode.GetSeries()
+	file := episode.GetEpisodeFile()
+	quality := file.GetQuality()
+	fileQuality := quality.GetQuality()
+
+	e.ID = types.Int64Value(int64(episode.GetId()))
+	e.SeriesID = types.Int64Value(int64(episode.GetSeriesId()))
+	e.SeriesTitle = types.StringValue(series.GetTitle())
+	e.SeasonNumber = types.Int64Value(int64(episode.GetSeasonNumber()))
+	e.EpisodeNumber = types.Int64Value(int64(episode.GetEpisodeNumber()))
+	e.Title = types.StringValue(episode.GetTitle())
+	e.CurrentQuality = types.StringValue(fileQuality.GetName())
+	e.CutoffQuality = types.StringValue(cutoffs[series.GetQualityProfileId()])
+}
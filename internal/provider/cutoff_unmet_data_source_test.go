@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCutoffUnmetDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccCutoffUnmetDataSourceConfig(5) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccCutoffUnmetDataSourceConfig(5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_cutoff_unmet.test", "id"),
+					resource.TestCheckResourceAttrSet("data.sonarr_cutoff_unmet.test", "total_records"),
+					resource.TestCheckResourceAttrSet("data.sonarr_cutoff_unmet.test", "episodes.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCutoffUnmetDataSourceConfig(pageSize int) string {
+	return fmt.Sprintf(`
+	data "sonarr_cutoff_unmet" "test" {
+		page_size = %d
+	}`, pageSize)
+}
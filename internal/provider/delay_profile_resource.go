@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const delayProfileResourceName = "delay_profile"
+const (
+	delayProfileResourceName = "delay_profile"
+	defaultDelayProfileID    = 1
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
@@ -115,9 +119,15 @@ func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 			},
 			"order": schema.Int64Attribute{
-				MarkdownDescription: "Order.",
+				MarkdownDescription: "Order. Profiles with a lower order take precedence, the default profile is always evaluated last and cannot be moved.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"minimum_custom_format_score": schema.Int64Attribute{
 				MarkdownDescription: "Minimum custom format score.",
@@ -172,13 +182,10 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created"+delayProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 
 	// Set order on create
-	if !profile.Order.IsUnknown() {
-		response.Order = request.Order
-
-		response, _, err = r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(response.GetId()))).DelayProfileResource(*response).Execute()
-		if err != nil {
-			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+	if !profile.Order.IsUnknown() && response.GetOrder() != request.GetOrder() {
+		response = r.setOrder(response, request.GetOrder(), &resp.Diagnostics)
 
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -214,14 +221,30 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 
 func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *DelayProfile
+	var (
+		profile *DelayProfile
+		order   types.Int64
+	)
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("order"), &order)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Default profile is always evaluated last
+	if profile.ID.ValueInt64() == defaultDelayProfileID && !profile.Order.IsUnknown() && !profile.Order.Equal(order) {
+		resp.Diagnostics.AddAttributeError(path.Root("order"), helpers.ResourceError, "Default delay profile order cannot be changed")
+
+		return
+	}
+
+	// Keep the current order when the planned one is not known
+	if profile.Order.IsUnknown() {
+		profile.Order = order
+	}
+
 	// Build Update resource
 	request := profile.read(ctx, &resp.Diagnostics)
 
@@ -265,6 +288,20 @@ func (r *DelayProfileResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Trace(ctx, "imported "+delayProfileResourceName+": "+req.ID)
 }
 
+// setOrder updates the profile with the configured order, since Sonarr assigns the next order on create.
+func (r *DelayProfileResource) setOrder(profile *sonarr.DelayProfileResource, order int32, diags *diag.Diagnostics) *sonarr.DelayProfileResource {
+	profile.SetOrder(order)
+
+	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(profile.GetId()))).DelayProfileResource(*profile).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+
+		return nil
+	}
+
+	return response
+}
+
 func (p *DelayProfile) write(ctx context.Context, profile *sonarr.DelayProfileResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
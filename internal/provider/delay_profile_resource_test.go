@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccDelayProfileResource(t *testing.T) {
@@ -25,6 +31,9 @@ func TestAccDelayProfileResource(t *testing.T) {
 				Config: testAccTagResourceConfig("test", "delay_profile_resource") + testAccDelayProfileResourceConfig("usenet", "sonarr_tag.test.id"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_delay_profile.test", "preferred_protocol", "usenet"),
+					resource.TestCheckResourceAttr("sonarr_delay_profile.test", "bypass_if_highest_quality", "true"),
+					resource.TestCheckResourceAttr("sonarr_delay_profile.test", "bypass_if_above_custom_format_score", "true"),
+					resource.TestCheckResourceAttr("sonarr_delay_profile.test", "minimum_custom_format_score", "10"),
 					resource.TestCheckResourceAttrSet("sonarr_delay_profile.test", "id"),
 				),
 			},
@@ -51,6 +60,113 @@ func TestAccDelayProfileResource(t *testing.T) {
 	})
 }
 
+func TestAccDelayProfileResource_order(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDelayProfileResourceOrderConfig(201, 202),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_delay_profile.first", "order", "201"),
+					resource.TestCheckResourceAttr("sonarr_delay_profile.second", "order", "202"),
+					testAccCheckDelayProfileOrders(map[string]int32{"sonarr_delay_profile.first": 201, "sonarr_delay_profile.second": 202, "sonarr_delay_profile.sibling": 210}),
+				),
+			},
+			// Swap order
+			{
+				Config: testAccDelayProfileResourceOrderConfig(204, 203),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_delay_profile.first", "order", "204"),
+					resource.TestCheckResourceAttr("sonarr_delay_profile.second", "order", "203"),
+					testAccCheckDelayProfileOrders(map[string]int32{"sonarr_delay_profile.first": 204, "sonarr_delay_profile.second": 203, "sonarr_delay_profile.sibling": 210}),
+				),
+			},
+			// Order 0 is not allowed
+			{
+				Config:      testAccDelayProfileResourceOrderConfig(0, 203),
+				ExpectError: regexp.MustCompile("at least 1"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDelayProfileResource_unsetOrder(t *testing.T) {
+	t.Parallel()
+
+	var order string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create without order
+			{
+				Config: testAccDelayProfileResourceUnsetOrderConfig(0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("sonarr_delay_profile.test", "order", func(value string) error {
+						order = value
+
+						return nil
+					}),
+				),
+			},
+			// Update keeps the order assigned by Sonarr
+			{
+				Config: testAccDelayProfileResourceUnsetOrderConfig(60),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("sonarr_delay_profile.test", tfjsonpath.New("order"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_delay_profile.test", "usenet_delay", "60"),
+					resource.TestCheckResourceAttrWith("sonarr_delay_profile.test", "order", func(value string) error {
+						if value != order {
+							return fmt.Errorf("expected order %s to be kept, got %s", order, value)
+						}
+
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckDelayProfileOrders checks through the API that every profile has its configured order.
+func testAccCheckDelayProfileOrders(expected map[string]int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		profiles, _, err := testAccAPIClient().DelayProfileAPI.ListDelayProfile(context.Background()).Execute()
+		if err != nil {
+			return err
+		}
+
+		orders := make(map[string]int32, len(profiles))
+		for _, p := range profiles {
+			orders[strconv.Itoa(int(p.GetId()))] = p.GetOrder()
+		}
+
+		for name, order := range expected {
+			rs, ok := s.RootModule().Resources[name]
+			if !ok {
+				return fmt.Errorf("resource not found: %s", name)
+			}
+
+			if actual, ok := orders[rs.Primary.ID]; !ok || actual != order {
+				return fmt.Errorf("expected %s to have order %d in Sonarr, got %d", name, order, actual)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccDelayProfileResourceConfig(protocol, tag string) string {
 	return fmt.Sprintf(`
 	resource "sonarr_delay_profile" "test" {
@@ -58,6 +174,7 @@ func testAccDelayProfileResourceConfig(protocol, tag string) string {
 		enable_torrent = true
 		bypass_if_highest_quality = true
 		bypass_if_above_custom_format_score = true
+		minimum_custom_format_score = 10
 		order = 100
 		usenet_delay = 0
 		torrent_delay = 0
@@ -65,3 +182,53 @@ func testAccDelayProfileResourceConfig(protocol, tag string) string {
 		tags = [%s]
 	}`, protocol, tag)
 }
+
+func testAccDelayProfileResourceOrderConfig(first, second int) string {
+	return fmt.Sprintf(`
+	resource "sonarr_tag" "first" {
+		label = "delayorderfirst"
+	}
+
+	resource "sonarr_tag" "second" {
+		label = "delayordersecond"
+	}
+
+	resource "sonarr_tag" "sibling" {
+		label = "delayordersibling"
+	}
+
+	resource "sonarr_delay_profile" "first" {
+		enable_usenet = true
+		preferred_protocol = "usenet"
+		order = %d
+		tags = [sonarr_tag.first.id]
+	}
+
+	resource "sonarr_delay_profile" "second" {
+		enable_torrent = true
+		preferred_protocol = "torrent"
+		order = %d
+		tags = [sonarr_tag.second.id]
+	}
+
+	resource "sonarr_delay_profile" "sibling" {
+		enable_usenet = true
+		preferred_protocol = "usenet"
+		order = 210
+		tags = [sonarr_tag.sibling.id]
+	}`, first, second)
+}
+
+func testAccDelayProfileResourceUnsetOrderConfig(delay int) string {
+	return fmt.Sprintf(`
+	resource "sonarr_tag" "test" {
+		label = "delayunsetorder"
+	}
+
+	resource "sonarr_delay_profile" "test" {
+		enable_usenet = true
+		preferred_protocol = "usenet"
+		usenet_delay = %d
+		tags = [sonarr_tag.test.id]
+	}`, delay)
+}
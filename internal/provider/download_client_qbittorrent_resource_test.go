@@ -65,3 +65,43 @@ func testAccDownloadClientQbittorrentResourceConfig(name, host string) string {
 		first_and_last = true
 	}`, name, host)
 }
+
+func TestAccDownloadClientQbittorrentResource_useSsl(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDownloadClientQbittorrentResourceSslConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_download_client_qbittorrent.test", "use_ssl", "true"),
+					resource.TestCheckResourceAttr("sonarr_download_client_qbittorrent.test", "port", "8443"),
+					resource.TestCheckResourceAttrSet("sonarr_download_client_qbittorrent.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_download_client_qbittorrent.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccDownloadClientQbittorrentResourceSslConfig = `
+	resource "sonarr_download_client_qbittorrent" "test" {
+		enable = false
+		priority = 1
+		name = "resourceQbittorrentSslTest"
+		host = "qbittorrent"
+		url_base = "/qbittorrent/"
+		port = 8443
+		use_ssl = true
+		tv_category = "tv-sonarr"
+	}
+`
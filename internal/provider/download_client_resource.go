@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -155,21 +157,33 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"remove_completed_downloads": schema.BoolAttribute{
 				MarkdownDescription: "Remove completed downloads flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"remove_failed_downloads": schema.BoolAttribute{
 				MarkdownDescription: "Remove failed downloads flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"priority": schema.Int64Attribute{
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "DownloadClient configuration template.",
@@ -208,46 +222,73 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				MarkdownDescription: "Add paused flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"use_ssl": schema.BoolAttribute{
 				MarkdownDescription: "Use SSL flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"start_on_add": schema.BoolAttribute{
 				MarkdownDescription: "Start on add flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"sequential_order": schema.BoolAttribute{
 				MarkdownDescription: "Sequential order flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"first_and_last": schema.BoolAttribute{
 				MarkdownDescription: "First and last flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"add_stopped": schema.BoolAttribute{
 				MarkdownDescription: "Add stopped flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"save_magnet_files": schema.BoolAttribute{
 				MarkdownDescription: "Save magnet files flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"read_only": schema.BoolAttribute{
 				MarkdownDescription: "Read only flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"port": schema.Int64Attribute{
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"recent_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Recent TV priority. `0` Last, `1` First.",
@@ -256,6 +297,9 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"older_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Older TV priority. `0` Last, `1` First.",
@@ -264,6 +308,9 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"initial_state": schema.Int64Attribute{
 				MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause.",
@@ -272,11 +319,17 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"intial_state": schema.Int64Attribute{
 				MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
@@ -422,6 +475,7 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 
 	state.writeSensitive(client)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.writePaths(client)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -450,6 +504,7 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 
 	state.writeSensitive(client)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.writePaths(client)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -480,6 +535,7 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 
 	state.writeSensitive(client)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.writePaths(client)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -512,6 +568,9 @@ func (r *DownloadClientResource) ImportState(ctx context.Context, req resource.I
 func (d *DownloadClient) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
+	// Keep the current values to restore paths Sonarr only changes the trailing separator of
+	current := *d
+
 	d.Tags, localDiag = types.SetValueFrom(ctx, types.Int64Type, downloadClient.Tags)
 	diags.Append(localDiag...)
 
@@ -528,6 +587,26 @@ func (d *DownloadClient) write(ctx context.Context, downloadClient *sonarr.Downl
 	d.FieldTags = types.SetValueMust(types.StringType, nil)
 	d.PostImportTags = types.SetValueMust(types.StringType, nil)
 	helpers.WriteFields(ctx, d, downloadClient.GetFields(), downloadClientFields)
+	d.writePaths(&current)
+}
+
+// paths returns the path like attributes, where a trailing separator does not change the meaning.
+func (d *DownloadClient) paths() []*types.String {
+	return []*types.String{&d.URLBase, &d.RPCPath, &d.TvDirectory, &d.Destination, &d.NzbFolder, &d.StrmFolder, &d.TorrentFolder, &d.WatchFolder}
+}
+
+// writePaths copy path attributes from another resource when they differ only by the trailing separator.
+func (d *DownloadClient) writePaths(client *DownloadClient) {
+	clientPaths := client.paths()
+	for i, p := range d.paths() {
+		if clientPaths[i].IsNull() || clientPaths[i].IsUnknown() {
+			continue
+		}
+
+		if strings.TrimRight(clientPaths[i].ValueString(), "/\\") == strings.TrimRight(p.ValueString(), "/\\") {
+			*p = *clientPaths[i]
+		}
+	}
 }
 
 func (d *DownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.DownloadClientResource {
@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/devopsarr/terraform-provider-sonarr/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const testUnitDownloadStationResource = "sonarr_download_client_torrent_download_station.test"
+
+func TestUnitDownloadClientTorrentDownloadStationResource_create(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "host = \"download-station\"\n\t\tport = 9091"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "id", "2"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "name", "station"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "host", "download-station"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "port", "9091"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_defaults(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "enable", "true"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "priority", "1"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "remove_completed_downloads", "true"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "remove_failed_downloads", "true"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "host", "localhost"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "port", "5000"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "use_ssl", "false"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_update(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "use_ssl = false"),
+				Check:  resource.TestCheckResourceAttr(testUnitDownloadStationResource, "use_ssl", "false"),
+			},
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "use_ssl = true\n\t\tport = 5001"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "id", "2"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "use_ssl", "true"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "port", "5001"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_knownValues(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", ""),
+			},
+			// Unset computed values are planned from state instead of known after apply
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "use_ssl = true"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(testUnitDownloadStationResource, tfjsonpath.New("enable"), knownvalue.Bool(true)),
+						plancheck.ExpectKnownValue(testUnitDownloadStationResource, tfjsonpath.New("priority"), knownvalue.Int64Exact(1)),
+						plancheck.ExpectKnownValue(testUnitDownloadStationResource, tfjsonpath.New("remove_completed_downloads"), knownvalue.Bool(true)),
+						plancheck.ExpectKnownValue(testUnitDownloadStationResource, tfjsonpath.New("remove_failed_downloads"), knownvalue.Bool(true)),
+						plancheck.ExpectKnownValue(testUnitDownloadStationResource, tfjsonpath.New("port"), knownvalue.Int64Exact(5000)),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(testUnitDownloadStationResource, "use_ssl", "true"),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_rename(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", ""),
+			},
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("renamed", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "id", "2"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "name", "renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_disabled(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "enable = false\n\t\tremove_completed_downloads = false\n\t\tremove_failed_downloads = false\n\t\tpriority = 5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "enable", "false"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "remove_completed_downloads", "false"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "remove_failed_downloads", "false"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "priority", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_category(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "tv_category = \"tv-sonarr\"\n\t\ttv_directory = \"/downloads/tv\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "tv_category", "tv-sonarr"),
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "tv_directory", "/downloads/tv"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_tags(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "tags = [1, 2]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testUnitDownloadStationResource, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(testUnitDownloadStationResource, "tags.*", "1"),
+					resource.TestCheckTypeSetElemAttr(testUnitDownloadStationResource, "tags.*", "2"),
+				),
+			},
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "tags = []"),
+				Check:  resource.TestCheckResourceAttr(testUnitDownloadStationResource, "tags.#", "0"),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_import(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "tv_category = \"tv-sonarr\""),
+			},
+			{
+				Config:            testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "tv_category = \"tv-sonarr\""),
+				ResourceName:      testUnitDownloadStationResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:        testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", ""),
+				ResourceName:  testUnitDownloadStationResource,
+				ImportState:   true,
+				ImportStateId: "wrong",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_unauthorized(t *testing.T) {
+	t.Parallel()
+
+	url, _, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testUnitProvider(url, "ErrorAPIKey") + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", ""),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_drift(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "port = 9091"),
+			},
+			// Changes made outside of terraform are detected
+			{
+				PreConfig: func() {
+					testUnitRequest(t, http.MethodPut, url+"/api/v3/downloadclient/2", key, `{"fields":[{"name":"port","value":9999}]}`, http.StatusAccepted)
+				},
+				Config:             testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "port = 9091"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// and reverted on apply
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "port = 9091"),
+				Check:  resource.TestCheckResourceAttr(testUnitDownloadStationResource, "port", "9091"),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_multiple(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", "") + `
+	resource "sonarr_download_client_torrent_download_station" "other" {
+		name = "other"
+		host = "other-station"
+	}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testUnitDownloadStationResource, "id"),
+					resource.TestCheckResourceAttrSet("sonarr_download_client_torrent_download_station.other", "id"),
+					resource.TestCheckResourceAttr("sonarr_download_client_torrent_download_station.other", "host", "other-station"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTorrentDownloadStationResource_delete(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			for _, rs := range s.RootModule().Resources {
+				if rs.Type != "sonarr_download_client_torrent_download_station" {
+					continue
+				}
+
+				if code := testUnitRequest(t, http.MethodGet, url+"/api/v3/downloadclient/"+rs.Primary.ID, key, "", 0); code != http.StatusNotFound {
+					return fmt.Errorf("download client %s still exists", rs.Primary.ID)
+				}
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTorrentDownloadStationResourceConfig("station", ""),
+			},
+		},
+	})
+
+	// the fixture download client is left untouched
+	if code := testUnitRequest(t, http.MethodGet, url+"/api/v3/downloadclient/1", key, "", 0); code != http.StatusOK {
+		t.Fatalf("fixture download client not found: %d", code)
+	}
+}
+
+func testUnitDownloadClientTorrentDownloadStationResourceConfig(name, attributes string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_download_client_torrent_download_station" "test" {
+		name = "%s"
+		%s
+	}`, name, attributes)
+}
+
+// testUnitRequest calls the mock server directly and returns the status code, failing if it differs from the expected one.
+func testUnitRequest(t *testing.T, method, url, key, body string, expected int) int {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set("X-Api-Key", key)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer resp.Body.Close()
+
+	if expected != 0 && resp.StatusCode != expected {
+		t.Fatalf("unexpected status %d for %s %s", resp.StatusCode, method, url)
+	}
+
+	return resp.StatusCode
+}
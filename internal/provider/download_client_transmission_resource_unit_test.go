@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/devopsarr/terraform-provider-sonarr/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testUnitTransmissionResource = "sonarr_download_client_transmission.test"
+
+func TestUnitDownloadClientTransmissionResource_addPaused(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTransmissionResourceConfig("add_paused = true\n\t\tremove_completed_downloads = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testUnitTransmissionResource, "add_paused", "true"),
+					resource.TestCheckResourceAttr(testUnitTransmissionResource, "remove_completed_downloads", "false"),
+					testUnitCheckDownloadClientPayload(t, url, key, testUnitTransmissionResource, "addPaused", true),
+					testUnitCheckDownloadClientPayload(t, url, key, testUnitTransmissionResource, "removeCompletedDownloads", false),
+				),
+			},
+			{
+				Config: testUnitProvider(url, key) + testUnitDownloadClientTransmissionResourceConfig("add_paused = false\n\t\tremove_completed_downloads = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testUnitCheckDownloadClientPayload(t, url, key, testUnitTransmissionResource, "addPaused", false),
+					testUnitCheckDownloadClientPayload(t, url, key, testUnitTransmissionResource, "removeCompletedDownloads", true),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClientTransmissionResource_partial(t *testing.T) {
+	t.Parallel()
+
+	url, key, cleanup := testutil.MockSonarrServer(t)
+	defer cleanup()
+
+	config := testUnitProvider(url, key) + testUnitDownloadClientTransmissionResourceConfig("url_base = \"/transmission\"")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testUnitTransmissionResource, "url_base", "/transmission"),
+					resource.TestCheckResourceAttrSet(testUnitTransmissionResource, "host"),
+					resource.TestCheckResourceAttrSet(testUnitTransmissionResource, "port"),
+				),
+			},
+			// Unset attributes must not drift
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestUnitDownloadClient_writePaths(t *testing.T) {
+	t.Parallel()
+
+	state := DownloadClient{
+		URLBase:     types.StringValue("/transmission/"),
+		TvDirectory: types.StringValue("/downloads"),
+		Destination: types.StringNull(),
+	}
+	state.writePaths(&DownloadClient{
+		URLBase:     types.StringValue("/transmission"),
+		TvDirectory: types.StringValue("/other"),
+		Destination: types.StringUnknown(),
+	})
+
+	if state.URLBase.ValueString() != "/transmission" {
+		t.Errorf("expected url_base to keep the configured value, got %s", state.URLBase)
+	}
+
+	if state.TvDirectory.ValueString() != "/downloads" {
+		t.Errorf("expected tv_directory to keep the Sonarr value, got %s", state.TvDirectory)
+	}
+
+	if !state.Destination.IsNull() {
+		t.Errorf("expected destination to stay null, got %s", state.Destination)
+	}
+}
+
+func testUnitDownloadClientTransmissionResourceConfig(attributes string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_download_client_transmission" "test" {
+		name = "transmission"
+		%s
+	}`, attributes)
+}
+
+// testUnitCheckDownloadClientPayload checks the value stored by the mock server for the given download client, looking in fields too.
+func testUnitCheckDownloadClientPayload(t *testing.T, url, key, resourceName, name string, expected any) resource.TestCheckFunc {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url+"/api/v3/downloadclient/"+rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("X-Api-Key", key)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		defer resp.Body.Close()
+
+		var body map[string]any
+
+		if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return err
+		}
+
+		value, ok := body[name]
+		if !ok {
+			fields, _ := body["fields"].([]any)
+			for _, f := range fields {
+				if field, _ := f.(map[string]any); field["name"] == name {
+					value = field["value"]
+				}
+			}
+		}
+
+		if value != expected {
+			return fmt.Errorf("expected %s to be %v, got %v", name, expected, value)
+		}
+
+		return nil
+	}
+}
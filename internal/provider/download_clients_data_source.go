@@ -6,8 +6,10 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -31,6 +33,8 @@ type DownloadClientsDataSource struct {
 type DownloadClients struct {
 	DownloadClients types.Set    `tfsdk:"download_clients"`
 	ID              types.String `tfsdk:"id"`
+	Protocol        types.String `tfsdk:"protocol"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
 }
 
 func (d *DownloadClientsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +50,17 @@ func (d *DownloadClientsDataSource) Schema(_ context.Context, _ datasource.Schem
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Return only the download clients with the given enable flag.",
+				Optional:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Return only the download clients using the given protocol. Valid values are 'usenet' and 'torrent'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("usenet", "torrent"),
+				},
+			},
 			"download_clients": schema.SetNestedAttribute{
 				MarkdownDescription: "Download Client list.",
 				Computed:            true,
@@ -245,7 +260,15 @@ func (d *DownloadClientsDataSource) Configure(ctx context.Context, req datasourc
 	}
 }
 
-func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *DownloadClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *DownloadClients
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get download clients current value
 	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute()
 	if err != nil {
@@ -256,12 +279,27 @@ func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadR
 
 	tflog.Trace(ctx, "read "+downloadClientsDataSourceName)
 	// Map response body to resource schema attribute
-	clients := make([]DownloadClient, len(response))
-	for i, d := range response {
-		clients[i].write(ctx, &d, &resp.Diagnostics)
+	clients := make([]DownloadClient, 0, len(response))
+
+	for _, d := range response {
+		if !data.Enabled.IsNull() && d.GetEnable() != data.Enabled.ValueBool() {
+			continue
+		}
+
+		if !data.Protocol.IsNull() && string(d.GetProtocol()) != data.Protocol.ValueString() {
+			continue
+		}
+
+		var client DownloadClient
+
+		client.write(ctx, &d, &resp.Diagnostics)
+		clients = append(clients, client)
 	}
 
 	clientList, diags := types.SetValueFrom(ctx, DownloadClient{}.getType(), clients)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, DownloadClients{DownloadClients: clientList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+
+	data.DownloadClients = clientList
+	data.ID = types.StringValue(strconv.Itoa(len(clients)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
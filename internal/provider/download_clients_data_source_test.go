@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDownloadClientsDataSource(t *testing.T) {
@@ -30,6 +33,14 @@ func TestAccDownloadClientsDataSource(t *testing.T) {
 					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_download_clients.test", "download_clients.*", map[string]string{"port": "9091"}),
 				),
 			},
+			// Filter by enabled and protocol
+			{
+				Config: testAccDownloadClientResourceConfig("datasourceTest", "true") + testAccDownloadClientsDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_download_clients.test", "download_clients.*", map[string]string{"name": "datasourceTest"}),
+					testAccCheckDownloadClientsEnabled("data.sonarr_download_clients.test"),
+				),
+			},
 		},
 	})
 }
@@ -38,3 +49,30 @@ const testAccDownloadClientsDataSourceConfig = `
 data "sonarr_download_clients" "test" {
 }
 `
+
+const testAccDownloadClientsDataSourceFilterConfig = `
+data "sonarr_download_clients" "test" {
+	enabled  = true
+	protocol = "torrent"
+
+	depends_on = [sonarr_download_client.test]
+}
+`
+
+// testAccCheckDownloadClientsEnabled checks all the returned download clients are enabled.
+func testAccCheckDownloadClientsEnabled(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("data source not found: %s", name)
+		}
+
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasSuffix(key, ".enable") && value != "true" {
+				return fmt.Errorf("unexpected disabled download client: %s", key)
+			}
+		}
+
+		return nil
+	}
+}
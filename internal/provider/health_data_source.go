@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const healthDataSourceName = "health"
+
+// healthSeverity lists the health check types by increasing severity.
+var healthSeverity = []string{
+	string(sonarr.HEALTHCHECKRESULT_OK),
+	string(sonarr.HEALTHCHECKRESULT_NOTICE),
+	string(sonarr.HEALTHCHECKRESULT_WARNING),
+	string(sonarr.HEALTHCHECKRESULT_ERROR),
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HealthDataSource{}
+
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+// HealthDataSource defines the health implementation.
+type HealthDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// Health describes the health data model.
+type Health struct {
+	Checks types.List   `tfsdk:"checks"`
+	ID     types.String `tfsdk:"id"`
+	Type   types.String `tfsdk:"type"`
+	FailOn types.String `tfsdk:"fail_on"`
+}
+
+// HealthCheck is part of Health.
+type HealthCheck struct {
+	Source  types.String `tfsdk:"source"`
+	Type    types.String `tfsdk:"type"`
+	Message types.String `tfsdk:"message"`
+	WikiURL types.String `tfsdk:"wiki_url"`
+}
+
+func (h HealthCheck) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"source":   types.StringType,
+			"type":     types.StringType,
+			"message":  types.StringType,
+			"wiki_url": types.StringType,
+		})
+}
+
+func (d *HealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + healthDataSourceName
+}
+
+func (d *HealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nList the current health checks.\nFor more information refer to [Health](https://wiki.servarr.com/sonarr/system#health) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Return only the checks of the given type. Valid values are 'ok', 'notice', 'warning' and 'error'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(healthSeverity...),
+				},
+			},
+			"fail_on": schema.StringAttribute{
+				MarkdownDescription: "Fail the read when any returned check is at least this severe. Valid values are 'notice', 'warning' and 'error'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(healthSeverity[1:]...),
+				},
+			},
+			"checks": schema.ListNestedAttribute{
+				MarkdownDescription: "Health checks.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							MarkdownDescription: "Check source.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Check type.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Check message.",
+							Computed:            true,
+						},
+						"wiki_url": schema.StringAttribute{
+							MarkdownDescription: "Wiki URL.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Health
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get health current value
+	response, _, err := d.client.HealthAPI.ListHealth(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, healthDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+healthDataSourceName)
+
+	// Map response body to resource schema attribute
+	checks := make([]HealthCheck, 0, len(response))
+	failures := make([]string, 0)
+
+	for _, h := range response {
+		if !data.Type.IsNull() && string(h.GetType()) != data.Type.ValueString() {
+			continue
+		}
+
+		if !data.FailOn.IsNull() && slices.Index(healthSeverity, string(h.GetType())) >= slices.Index(healthSeverity, data.FailOn.ValueString()) {
+			failures = append(failures, fmt.Sprintf("%s (%s): %s", h.GetSource(), h.GetType(), h.GetMessage()))
+		}
+
+		var check HealthCheck
+
+		check.write(&h)
+		checks = append(checks, check)
+	}
+
+	if len(failures) > 0 {
+		resp.Diagnostics.AddError(helpers.DataSourceError, fmt.Sprintf("Sonarr health checks failed:\n%s", strings.Join(failures, "\n")))
+
+		return
+	}
+
+	checkList, diags := types.ListValueFrom(ctx, HealthCheck{}.getType(), checks)
+	resp.Diagnostics.Append(diags...)
+
+	data.Checks = checkList
+	data.ID = types.StringValue(strconv.Itoa(len(checks)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (h *HealthCheck) write(health *sonarr.HealthResource) {
+	h.Source = types.StringValue(health.GetSource())
+	h.Type = types.StringValue(string(health.GetType()))
+	h.Message = types.StringValue(health.GetMessage())
+	h.WikiURL = types.StringValue(health.GetWikiUrl())
+}
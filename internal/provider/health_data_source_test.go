@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHealthDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccHealthDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccHealthDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_health.test", "id"),
+					resource.TestCheckResourceAttrSet("data.sonarr_health.test", "checks.#"),
+				),
+			},
+			// Type filter
+			{
+				Config: `
+				data "sonarr_health" "test" {
+					type = "ok"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_health.test", "checks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccHealthDataSourceConfig = `
+data "sonarr_health" "test" {
+}
+`
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	historyDataSourceName = "history"
+	historyDefaultLimit   = 100
+	historyPageSize       = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HistoryDataSource{}
+
+func NewHistoryDataSource() datasource.DataSource {
+	return &HistoryDataSource{}
+}
+
+// HistoryDataSource defines the history implementation.
+type HistoryDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// History describes the history data model.
+type History struct {
+	Records types.List   `tfsdk:"records"`
+	ID      types.String `tfsdk:"id"`
+	Since   types.String `tfsdk:"since"`
+	Until   types.String `tfsdk:"until"`
+	Limit   types.Int64  `tfsdk:"limit"`
+}
+
+// HistoryRecord is part of History.
+type HistoryRecord struct {
+	SourceTitle types.String `tfsdk:"source_title"`
+	EventType   types.String `tfsdk:"event_type"`
+	Date        types.String `tfsdk:"date"`
+	DownloadID  types.String `tfsdk:"download_id"`
+	Quality     types.String `tfsdk:"quality"`
+	ID          types.Int64  `tfsdk:"id"`
+	SeriesID    types.Int64  `tfsdk:"series_id"`
+	EpisodeID   types.Int64  `tfsdk:"episode_id"`
+}
+
+func (h HistoryRecord) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"id":           types.Int64Type,
+			"series_id":    types.Int64Type,
+			"episode_id":   types.Int64Type,
+			"source_title": types.StringType,
+			"event_type":   types.StringType,
+			"date":         types.StringType,
+			"download_id":  types.StringType,
+			"quality":      types.StringType,
+		})
+}
+
+func (d *HistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + historyDataSourceName
+}
+
+func (d *HistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nList the most recent history records, newest first.\nFor more information refer to [History](https://wiki.servarr.com/sonarr/activity#history) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Return only records from this date, in RFC3339 format.",
+				Optional:            true,
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "Return only records up to this date, in RFC3339 format.",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of records. Defaults to `%d`.", historyDefaultLimit),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "History records sorted by date descending.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "History record ID.",
+							Computed:            true,
+						},
+						"series_id": schema.Int64Attribute{
+							MarkdownDescription: "Series ID.",
+							Computed:            true,
+						},
+						"episode_id": schema.Int64Attribute{
+							MarkdownDescription: "Episode ID.",
+							Computed:            true,
+						},
+						"source_title": schema.StringAttribute{
+							MarkdownDescription: "Release title.",
+							Computed:            true,
+						},
+						"event_type": schema.StringAttribute{
+							MarkdownDescription: "Event type.",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Event date in RFC3339 format.",
+							Computed:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download ID.",
+							Computed:            true,
+						},
+						"quality": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *HistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *History
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := parseDate(data.Since, "since", &resp.Diagnostics)
+	until := parseDate(data.Until, "until", &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := historyDefaultLimit
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	// Get history pages until the window or the limit is exceeded
+	history := make([]sonarr.HistoryResource, 0, limit)
+
+	for page, done := int32(1), false; !done; page++ {
+		response, _, err := d.client.HistoryAPI.GetHistory(d.auth).
+			Page(page).
+			PageSize(historyPageSize).
+			SortKey("date").
+			SortDirection(sonarr.SORTDIRECTION_DESCENDING).
+			Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, historyDataSourceName, err))
+
+			return
+		}
+
+		tflog.Trace(ctx, "read "+historyDataSourceName+" page "+strconv.Itoa(int(page)))
+
+		for _, record := range response.GetRecords() {
+			if !until.IsZero() && record.GetDate().After(until) {
+				continue
+			}
+
+			// records are sorted, so older ones are all out of the window
+			if !since.IsZero() && record.GetDate().Before(since) {
+				done = true
+
+				break
+			}
+
+			history = append(history, record)
+			if len(history) >= limit {
+				done = true
+
+				break
+			}
+		}
+
+		done = done || len(response.GetRecords()) == 0 || page*historyPageSize >= response.GetTotalRecords()
+	}
+
+	slices.SortStableFunc(history, func(a, b sonarr.HistoryResource) int {
+		return b.GetDate().Compare(a.GetDate())
+	})
+
+	// Map response body to resource schema attribute
+	records := make([]HistoryRecord, len(history))
+	for i, h := range history {
+		records[i].write(&h)
+	}
+
+	recordList, diags := types.ListValueFrom(ctx, HistoryRecord{}.getType(), records)
+	resp.Diagnostics.Append(diags...)
+
+	data.Records = recordList
+	data.ID = types.StringValue(strconv.Itoa(len(records)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// parseDate parses an optional RFC3339 date, returning the zero time if not set.
+func parseDate(value types.String, attribute string, diags *diag.Diagnostics) time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}
+	}
+
+	date, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root(attribute), helpers.DataSourceError, fmt.Sprintf("Invalid RFC3339 date '%s': %s", value.ValueString(), err))
+	}
+
+	return date
+}
+
+func (h *HistoryRecord) write(history *sonarr.HistoryResource) {
+	quality := history.Quality.GetQuality()
+
+	h.ID = types.Int64Value(int64(history.GetId()))
+	h.SeriesID = types.Int64Value(int64(history.GetSeriesId()))
+	h.EpisodeID = types.Int64Value(int64(history.GetEpisodeId()))
+	h.SourceTitle = types.StringValue(history.GetSourceTitle())
+	h.EventType = types.StringValue(string(history.GetEventType()))
+	h.Date = types.StringValue(history.GetDate().Format(time.RFC3339))
+	h.DownloadID = types.StringValue(history.GetDownloadId())
+	h.Quality = types.StringValue(quality.GetName())
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHistoryDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccHistoryDataSourceConfig("2000-01-01T00:00:00Z", 10) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid date
+			{
+				Config:      testAccHistoryDataSourceConfig("yesterday", 10),
+				ExpectError: regexp.MustCompile("Invalid RFC3339 date"),
+			},
+			// Read testing
+			{
+				Config: testAccHistoryDataSourceConfig("2000-01-01T00:00:00Z", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_history.test", "id"),
+					resource.TestCheckResourceAttrSet("data.sonarr_history.test", "records.#"),
+				),
+			},
+			// Empty window
+			{
+				Config: testAccHistoryDataSourceConfig("2999-01-01T00:00:00Z", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_history.test", "records.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHistoryDataSourceConfig(since string, limit int) string {
+	return fmt.Sprintf(`
+	data "sonarr_history" "test" {
+		since = "%s"
+		limit = %d
+	}`, since, limit)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -42,46 +43,22 @@ type ImportListCustomResource struct {
 
 // ImportListCustom describes the import list data model.
 type ImportListCustom struct {
-	Tags               types.Set    `tfsdk:"tags"`
-	Name               types.String `tfsdk:"name"`
-	ShouldMonitor      types.String `tfsdk:"should_monitor"`
-	RootFolderPath     types.String `tfsdk:"root_folder_path"`
-	SeriesType         types.String `tfsdk:"series_type"`
-	BaseURL            types.String `tfsdk:"base_url"`
-	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
-	ID                 types.Int64  `tfsdk:"id"`
-	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	BaseURL types.String `tfsdk:"base_url"`
+	ImportListBase
 }
 
 func (i ImportListCustom) toImportList() *ImportList {
 	return &ImportList{
-		Tags:               i.Tags,
-		Name:               i.Name,
-		ShouldMonitor:      i.ShouldMonitor,
-		RootFolderPath:     i.RootFolderPath,
-		SeriesType:         i.SeriesType,
-		BaseURL:            i.BaseURL,
-		QualityProfileID:   i.QualityProfileID,
-		ID:                 i.ID,
-		EnableAutomaticAdd: i.EnableAutomaticAdd,
-		SeasonFolder:       i.SeasonFolder,
-		ConfigContract:     types.StringValue(importListCustomConfigContract),
-		Implementation:     types.StringValue(importListCustomImplementation),
+		ImportListBase: i.ImportListBase,
+		BaseURL:        i.BaseURL,
+		ConfigContract: types.StringValue(importListCustomConfigContract),
+		Implementation: types.StringValue(importListCustomImplementation),
 	}
 }
 
 func (i *ImportListCustom) fromImportList(importList *ImportList) {
-	i.Tags = importList.Tags
-	i.Name = importList.Name
-	i.ShouldMonitor = importList.ShouldMonitor
-	i.RootFolderPath = importList.RootFolderPath
-	i.SeriesType = importList.SeriesType
+	i.ImportListBase = importList.ImportListBase
 	i.BaseURL = importList.BaseURL
-	i.QualityProfileID = importList.QualityProfileID
-	i.ID = importList.ID
-	i.EnableAutomaticAdd = importList.EnableAutomaticAdd
-	i.SeasonFolder = importList.SeasonFolder
 }
 
 func (r *ImportListCustomResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -93,13 +70,21 @@ func (r *ImportListCustomResource) Schema(_ context.Context, _ resource.SchemaRe
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList Custom resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [Custom](https://wiki.servarr.com/sonarr/supported#customimport).",
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_add": schema.BoolAttribute{
-				MarkdownDescription: "Enable automatic add flag.",
-				Required:            true,
+				MarkdownDescription: "Automatically add the series found by the list, when `false` they are only listed for discovery. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
 			},
 			"season_folder": schema.BoolAttribute{
 				MarkdownDescription: "Season folder flag.",
 				Required:            true,
 			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for missing episodes when a series is added. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"quality_profile_id": schema.Int64Attribute{
 				MarkdownDescription: "Quality profile ID.",
 				Required:            true,
@@ -44,6 +44,10 @@ func (d *ImportListDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				MarkdownDescription: "Season folder flag.",
 				Computed:            true,
 			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for missing episodes when a series is added.",
+				Computed:            true,
+			},
 			"quality_profile_id": schema.Int64Attribute{
 				MarkdownDescription: "Quality profile ID.",
 				Computed:            true,
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -42,46 +43,22 @@ type ImportListImdbResource struct {
 
 // ImportListImdb describes the import list data model.
 type ImportListImdb struct {
-	Tags               types.Set    `tfsdk:"tags"`
-	Name               types.String `tfsdk:"name"`
-	ShouldMonitor      types.String `tfsdk:"should_monitor"`
-	RootFolderPath     types.String `tfsdk:"root_folder_path"`
-	SeriesType         types.String `tfsdk:"series_type"`
-	ListID             types.String `tfsdk:"list_id"`
-	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
-	ID                 types.Int64  `tfsdk:"id"`
-	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	ListID types.String `tfsdk:"list_id"`
+	ImportListBase
 }
 
 func (i ImportListImdb) toImportList() *ImportList {
 	return &ImportList{
-		Tags:               i.Tags,
-		Name:               i.Name,
-		ShouldMonitor:      i.ShouldMonitor,
-		RootFolderPath:     i.RootFolderPath,
-		SeriesType:         i.SeriesType,
-		ListID:             i.ListID,
-		QualityProfileID:   i.QualityProfileID,
-		ID:                 i.ID,
-		EnableAutomaticAdd: i.EnableAutomaticAdd,
-		SeasonFolder:       i.SeasonFolder,
-		ConfigContract:     types.StringValue(importListImdbConfigContract),
-		Implementation:     types.StringValue(importListImdbImplementation),
+		ImportListBase: i.ImportListBase,
+		ListID:         i.ListID,
+		ConfigContract: types.StringValue(importListImdbConfigContract),
+		Implementation: types.StringValue(importListImdbImplementation),
 	}
 }
 
 func (i *ImportListImdb) fromImportList(importList *ImportList) {
-	i.Tags = importList.Tags
-	i.Name = importList.Name
-	i.ShouldMonitor = importList.ShouldMonitor
-	i.RootFolderPath = importList.RootFolderPath
-	i.SeriesType = importList.SeriesType
+	i.ImportListBase = importList.ImportListBase
 	i.ListID = importList.ListID
-	i.QualityProfileID = importList.QualityProfileID
-	i.ID = importList.ID
-	i.EnableAutomaticAdd = importList.EnableAutomaticAdd
-	i.SeasonFolder = importList.SeasonFolder
 }
 
 func (r *ImportListImdbResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -93,13 +70,21 @@ func (r *ImportListImdbResource) Schema(_ context.Context, _ resource.SchemaRequ
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList Imdb resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [Imdb](https://wiki.servarr.com/sonarr/supported#imdbimport).",
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_add": schema.BoolAttribute{
-				MarkdownDescription: "Enable automatic add flag.",
-				Required:            true,
+				MarkdownDescription: "Automatically add the series found by the list, when `false` they are only listed for discovery. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
 			},
 			"season_folder": schema.BoolAttribute{
 				MarkdownDescription: "Season folder flag.",
 				Required:            true,
 			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for missing episodes when a series is added. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"quality_profile_id": schema.Int64Attribute{
 				MarkdownDescription: "Quality profile ID.",
 				Required:            true,
@@ -52,6 +52,33 @@ func TestAccImportListImdbResource(t *testing.T) {
 	})
 }
 
+func TestAccImportListImdbResource_automaticAdd(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with default enable_automatic_add
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccImportListImdbResourceAutomaticAddConfig("resourceImdbAutoTest", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_import_list_imdb.test", "enable_automatic_add", "true"),
+				),
+			},
+			// Update to a discovery only list
+			{
+				Config: testAccImportListImdbResourceAutomaticAddConfig("resourceImdbAutoTest", "enable_automatic_add = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_import_list_imdb.test", "enable_automatic_add", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccImportListImdbResourceConfig(name, folder string) string {
 	return fmt.Sprintf(`
 
@@ -67,3 +94,19 @@ func testAccImportListImdbResourceConfig(name, folder string) string {
 		tags = []
 	}`, folder, name)
 }
+
+func testAccImportListImdbResourceAutomaticAddConfig(name, add string) string {
+	return fmt.Sprintf(`
+
+	resource "sonarr_import_list_imdb" "test" {
+		%s
+		season_folder = false
+		should_monitor = "all"
+		series_type = "standard"
+		root_folder_path = "/config"
+		quality_profile_id = 1
+		name = "%s"
+		list_id = "ls87654321"
+		tags = []
+	}`, add, name)
+}
@@ -26,6 +26,7 @@ func TestAccImportListPlexResource(t *testing.T) {
 				Config:    testAccImportListPlexResourceConfig("resourcePlexTest", "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_import_list_plex.test", "season_folder", "false"),
+					resource.TestCheckResourceAttr("sonarr_import_list_plex.test", "search_on_add", "false"),
 					resource.TestCheckResourceAttrSet("sonarr_import_list_plex.test", "id"),
 				),
 			},
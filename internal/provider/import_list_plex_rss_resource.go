@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -42,46 +43,22 @@ type ImportListPlexRSSResource struct {
 
 // ImportListPlexRSS describes the import list data model.
 type ImportListPlexRSS struct {
-	Tags               types.Set    `tfsdk:"tags"`
-	Name               types.String `tfsdk:"name"`
-	ShouldMonitor      types.String `tfsdk:"should_monitor"`
-	RootFolderPath     types.String `tfsdk:"root_folder_path"`
-	SeriesType         types.String `tfsdk:"series_type"`
-	URL                types.String `tfsdk:"url"`
-	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
-	ID                 types.Int64  `tfsdk:"id"`
-	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	URL types.String `tfsdk:"url"`
+	ImportListBase
 }
 
 func (i ImportListPlexRSS) toImportList() *ImportList {
 	return &ImportList{
-		Tags:               i.Tags,
-		Name:               i.Name,
-		ShouldMonitor:      i.ShouldMonitor,
-		RootFolderPath:     i.RootFolderPath,
-		SeriesType:         i.SeriesType,
-		URL:                i.URL,
-		QualityProfileID:   i.QualityProfileID,
-		ID:                 i.ID,
-		EnableAutomaticAdd: i.EnableAutomaticAdd,
-		SeasonFolder:       i.SeasonFolder,
-		ConfigContract:     types.StringValue(importListPlexRSSConfigContract),
-		Implementation:     types.StringValue(importListPlexRSSImplementation),
+		ImportListBase: i.ImportListBase,
+		URL:            i.URL,
+		ConfigContract: types.StringValue(importListPlexRSSConfigContract),
+		Implementation: types.StringValue(importListPlexRSSImplementation),
 	}
 }
 
 func (i *ImportListPlexRSS) fromImportList(importList *ImportList) {
-	i.Tags = importList.Tags
-	i.Name = importList.Name
-	i.ShouldMonitor = importList.ShouldMonitor
-	i.RootFolderPath = importList.RootFolderPath
-	i.SeriesType = importList.SeriesType
+	i.ImportListBase = importList.ImportListBase
 	i.URL = importList.URL
-	i.QualityProfileID = importList.QualityProfileID
-	i.ID = importList.ID
-	i.EnableAutomaticAdd = importList.EnableAutomaticAdd
-	i.SeasonFolder = importList.SeasonFolder
 }
 
 func (r *ImportListPlexRSSResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -93,13 +70,21 @@ func (r *ImportListPlexRSSResource) Schema(_ context.Context, _ resource.SchemaR
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList Plex RSS resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [Plex RSS](https://wiki.servarr.com/sonarr/supported#plexrssimport).",
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_add": schema.BoolAttribute{
-				MarkdownDescription: "Enable automatic add flag.",
-				Required:            true,
+				MarkdownDescription: "Automatically add the series found by the list, when `false` they are only listed for discovery. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
 			},
 			"season_folder": schema.BoolAttribute{
 				MarkdownDescription: "Season folder flag.",
 				Required:            true,
 			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for missing episodes when a series is added. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"quality_profile_id": schema.Int64Attribute{
 				MarkdownDescription: "Quality profile ID.",
 				Required:            true,
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,17 +42,26 @@ type ImportListResource struct {
 	auth   context.Context
 }
 
+// ImportListBase contains the fields shared by all the import list implementations.
+type ImportListBase struct {
+	Tags               types.Set    `tfsdk:"tags"`
+	Name               types.String `tfsdk:"name"`
+	ShouldMonitor      types.String `tfsdk:"should_monitor"`
+	RootFolderPath     types.String `tfsdk:"root_folder_path"`
+	SeriesType         types.String `tfsdk:"series_type"`
+	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
+	ID                 types.Int64  `tfsdk:"id"`
+	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
+	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	SearchOnAdd        types.Bool   `tfsdk:"search_on_add"`
+}
+
 // ImportList describes the download client data model.
 type ImportList struct {
-	Tags                      types.Set    `tfsdk:"tags"`
 	LanguageProfileIDs        types.Set    `tfsdk:"language_profile_ids"`
 	ProfileIDs                types.Set    `tfsdk:"quality_profile_ids"`
 	TagIDs                    types.Set    `tfsdk:"tag_ids"`
 	Implementation            types.String `tfsdk:"implementation"`
-	Name                      types.String `tfsdk:"name"`
-	ShouldMonitor             types.String `tfsdk:"should_monitor"`
-	RootFolderPath            types.String `tfsdk:"root_folder_path"`
-	SeriesType                types.String `tfsdk:"series_type"`
 	ConfigContract            types.String `tfsdk:"config_contract"`
 	AccessToken               types.String `tfsdk:"access_token"`
 	RefreshToken              types.String `tfsdk:"refresh_token"`
@@ -67,13 +77,10 @@ type ImportList struct {
 	Years                     types.String `tfsdk:"years"`
 	APIKey                    types.String `tfsdk:"api_key"`
 	TraktAdditionalParameters types.String `tfsdk:"trakt_additional_parameters"`
-	QualityProfileID          types.Int64  `tfsdk:"quality_profile_id"`
-	ID                        types.Int64  `tfsdk:"id"`
-	Limit                     types.Int64  `tfsdk:"limit"`
-	TraktListType             types.Int64  `tfsdk:"trakt_list_type"`
-	ListType                  types.Int64  `tfsdk:"list_type"`
-	EnableAutomaticAdd        types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder              types.Bool   `tfsdk:"season_folder"`
+	ImportListBase
+	Limit         types.Int64 `tfsdk:"limit"`
+	TraktListType types.Int64 `tfsdk:"trakt_list_type"`
+	ListType      types.Int64 `tfsdk:"list_type"`
 }
 
 func (i ImportList) getType() attr.Type {
@@ -110,6 +117,7 @@ func (i ImportList) getType() attr.Type {
 			"list_type":                   types.Int64Type,
 			"enable_automatic_add":        types.BoolType,
 			"season_folder":               types.BoolType,
+			"search_on_add":               types.BoolType,
 		})
 }
 
@@ -122,15 +130,22 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nGeneric Import List resource. When possible use a specific resource instead.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists).",
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_add": schema.BoolAttribute{
-				MarkdownDescription: "Enable automatic add flag.",
+				MarkdownDescription: "Automatically add the series found by the list, when `false` they are only listed for discovery. Defaults to `true`.",
 				Optional:            true,
 				Computed:            true,
+				Default:             booldefault.StaticBool(true),
 			},
 			"season_folder": schema.BoolAttribute{
 				MarkdownDescription: "Season folder flag.",
 				Optional:            true,
 				Computed:            true,
 			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for missing episodes when a series is added. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"quality_profile_id": schema.Int64Attribute{
 				MarkdownDescription: "Quality profile ID.",
 				Optional:            true,
@@ -406,21 +421,10 @@ func (r *ImportListResource) ImportState(ctx context.Context, req resource.Impor
 }
 
 func (i *ImportList) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
-	var localDiag diag.Diagnostics
-
-	i.Tags, localDiag = types.SetValueFrom(ctx, types.Int64Type, importList.Tags)
-	diags.Append(localDiag...)
+	i.writeImportListBase(ctx, importList, diags)
 
-	i.EnableAutomaticAdd = types.BoolValue(importList.GetEnableAutomaticAdd())
-	i.SeasonFolder = types.BoolValue(importList.GetSeasonFolder())
-	i.QualityProfileID = types.Int64Value(int64(importList.GetQualityProfileId()))
-	i.ID = types.Int64Value(int64(importList.GetId()))
 	i.ConfigContract = types.StringValue(importList.GetConfigContract())
 	i.Implementation = types.StringValue(importList.GetImplementation())
-	i.ShouldMonitor = types.StringValue(string(importList.GetShouldMonitor()))
-	i.RootFolderPath = types.StringValue(importList.GetRootFolderPath())
-	i.SeriesType = types.StringValue(string(importList.GetSeriesType()))
-	i.Name = types.StringValue(importList.GetName())
 	i.LanguageProfileIDs = types.SetValueMust(types.Int64Type, nil)
 	i.ProfileIDs = types.SetValueMust(types.Int64Type, nil)
 	i.TagIDs = types.SetValueMust(types.Int64Type, nil)
@@ -429,18 +433,42 @@ func (i *ImportList) write(ctx context.Context, importList *sonarr.ImportListRes
 
 func (i *ImportList) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.ImportListResource {
 	list := sonarr.NewImportListResource()
-	list.SetEnableAutomaticAdd(i.EnableAutomaticAdd.ValueBool())
-	list.SetSeasonFolder(i.SeasonFolder.ValueBool())
-	list.SetQualityProfileId(int32(i.QualityProfileID.ValueInt64()))
-	list.SetId(int32(i.ID.ValueInt64()))
-	list.SetShouldMonitor(sonarr.MonitorTypes(i.ShouldMonitor.ValueString()))
-	list.SetRootFolderPath(i.RootFolderPath.ValueString())
-	list.SetSeriesType(sonarr.SeriesTypes(i.SeriesType.ValueString()))
+	i.readImportListBase(ctx, list, diags)
 	list.SetConfigContract(i.ConfigContract.ValueString())
 	list.SetImplementation(i.Implementation.ValueString())
-	list.SetName(i.Name.ValueString())
-	diags.Append(i.Tags.ElementsAs(ctx, &list.Tags, true)...)
 	list.SetFields(helpers.ReadFields(ctx, i, importListFields))
 
 	return list
 }
+
+// writeImportListBase writes the fields shared by all the import list implementations.
+func (b *ImportListBase) writeImportListBase(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	b.Tags, localDiag = types.SetValueFrom(ctx, types.Int64Type, importList.Tags)
+	diags.Append(localDiag...)
+
+	b.EnableAutomaticAdd = types.BoolValue(importList.GetEnableAutomaticAdd())
+	b.SeasonFolder = types.BoolValue(importList.GetSeasonFolder())
+	b.SearchOnAdd = types.BoolValue(importList.GetSearchForMissingEpisodes())
+	b.QualityProfileID = types.Int64Value(int64(importList.GetQualityProfileId()))
+	b.ID = types.Int64Value(int64(importList.GetId()))
+	b.ShouldMonitor = types.StringValue(string(importList.GetShouldMonitor()))
+	b.RootFolderPath = types.StringValue(importList.GetRootFolderPath())
+	b.SeriesType = types.StringValue(string(importList.GetSeriesType()))
+	b.Name = types.StringValue(importList.GetName())
+}
+
+// readImportListBase reads the fields shared by all the import list implementations.
+func (b *ImportListBase) readImportListBase(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
+	importList.SetEnableAutomaticAdd(b.EnableAutomaticAdd.ValueBool())
+	importList.SetSeasonFolder(b.SeasonFolder.ValueBool())
+	importList.SetSearchForMissingEpisodes(b.SearchOnAdd.ValueBool())
+	importList.SetQualityProfileId(int32(b.QualityProfileID.ValueInt64()))
+	importList.SetId(int32(b.ID.ValueInt64()))
+	importList.SetShouldMonitor(sonarr.MonitorTypes(b.ShouldMonitor.ValueString()))
+	importList.SetRootFolderPath(b.RootFolderPath.ValueString())
+	importList.SetSeriesType(sonarr.SeriesTypes(b.SeriesType.ValueString()))
+	importList.SetName(b.Name.ValueString())
+	diags.Append(b.Tags.ElementsAs(ctx, &importList.Tags, true)...)
+}
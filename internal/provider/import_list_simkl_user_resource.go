@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -43,58 +44,34 @@ type ImportListSimklUserResource struct {
 
 // ImportListSimklUser describes the import list data model.
 type ImportListSimklUser struct {
-	Tags               types.Set    `tfsdk:"tags"`
-	Name               types.String `tfsdk:"name"`
-	ShouldMonitor      types.String `tfsdk:"should_monitor"`
-	RootFolderPath     types.String `tfsdk:"root_folder_path"`
-	SeriesType         types.String `tfsdk:"series_type"`
-	AccessToken        types.String `tfsdk:"access_token"`
-	RefreshToken       types.String `tfsdk:"refresh_token"`
-	Expires            types.String `tfsdk:"expires"`
-	AuthUser           types.String `tfsdk:"auth_user"`
-	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
-	ID                 types.Int64  `tfsdk:"id"`
-	ListType           types.Int64  `tfsdk:"list_type"`
-	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	Expires      types.String `tfsdk:"expires"`
+	AuthUser     types.String `tfsdk:"auth_user"`
+	ImportListBase
+	ListType types.Int64 `tfsdk:"list_type"`
 }
 
 func (i ImportListSimklUser) toImportList() *ImportList {
 	return &ImportList{
-		Tags:               i.Tags,
-		Name:               i.Name,
-		ShouldMonitor:      i.ShouldMonitor,
-		RootFolderPath:     i.RootFolderPath,
-		SeriesType:         i.SeriesType,
-		AccessToken:        i.AccessToken,
-		RefreshToken:       i.RefreshToken,
-		Expires:            i.Expires,
-		AuthUser:           i.AuthUser,
-		ListType:           i.ListType,
-		QualityProfileID:   i.QualityProfileID,
-		ID:                 i.ID,
-		EnableAutomaticAdd: i.EnableAutomaticAdd,
-		SeasonFolder:       i.SeasonFolder,
-		ConfigContract:     types.StringValue(importListSimklUserConfigContract),
-		Implementation:     types.StringValue(importListSimklUserImplementation),
+		ImportListBase: i.ImportListBase,
+		AccessToken:    i.AccessToken,
+		RefreshToken:   i.RefreshToken,
+		Expires:        i.Expires,
+		AuthUser:       i.AuthUser,
+		ListType:       i.ListType,
+		ConfigContract: types.StringValue(importListSimklUserConfigContract),
+		Implementation: types.StringValue(importListSimklUserImplementation),
 	}
 }
 
 func (i *ImportListSimklUser) fromImportList(importList *ImportList) {
-	i.Tags = importList.Tags
-	i.Name = importList.Name
-	i.ShouldMonitor = importList.ShouldMonitor
-	i.RootFolderPath = importList.RootFolderPath
-	i.SeriesType = importList.SeriesType
+	i.ImportListBase = importList.ImportListBase
 	i.AccessToken = importList.AccessToken
 	i.RefreshToken = importList.RefreshToken
 	i.Expires = importList.Expires
 	i.AuthUser = importList.AuthUser
 	i.ListType = importList.ListType
-	i.QualityProfileID = importList.QualityProfileID
-	i.ID = importList.ID
-	i.EnableAutomaticAdd = importList.EnableAutomaticAdd
-	i.SeasonFolder = importList.SeasonFolder
 }
 
 func (r *ImportListSimklUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -106,13 +83,21 @@ func (r *ImportListSimklUserResource) Schema(_ context.Context, _ resource.Schem
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList SimklUser resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [SimklUser](https://wiki.servarr.com/sonarr/supported#simkl_user).",
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_add": schema.BoolAttribute{
-				MarkdownDescription: "Enable automatic add flag.",
-				Required:            true,
+				MarkdownDescription: "Automatically add the series found by the list, when `false` they are only listed for discovery. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
 			},
 			"season_folder": schema.BoolAttribute{
 				MarkdownDescription: "Season folder flag.",
 				Required:            true,
 			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for missing episodes when a series is added. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"quality_profile_id": schema.Int64Attribute{
 				MarkdownDescription: "Quality profile ID.",
 				Required:            true,
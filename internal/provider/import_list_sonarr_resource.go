@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -42,58 +43,34 @@ type ImportListSonarrResource struct {
 
 // ImportListSonarr describes the import list data model.
 type ImportListSonarr struct {
-	Tags               types.Set    `tfsdk:"tags"`
 	LanguageProfileIDs types.Set    `tfsdk:"language_profile_ids"`
 	ProfileIDs         types.Set    `tfsdk:"quality_profile_ids"`
 	TagIDs             types.Set    `tfsdk:"tag_ids"`
-	Name               types.String `tfsdk:"name"`
-	ShouldMonitor      types.String `tfsdk:"should_monitor"`
-	RootFolderPath     types.String `tfsdk:"root_folder_path"`
-	SeriesType         types.String `tfsdk:"series_type"`
 	BaseURL            types.String `tfsdk:"base_url"`
 	APIKey             types.String `tfsdk:"api_key"`
-	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
-	ID                 types.Int64  `tfsdk:"id"`
-	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	ImportListBase
 }
 
 func (i ImportListSonarr) toImportList() *ImportList {
 	return &ImportList{
-		Tags:               i.Tags,
+		ImportListBase:     i.ImportListBase,
 		LanguageProfileIDs: i.LanguageProfileIDs,
 		ProfileIDs:         i.ProfileIDs,
 		TagIDs:             i.TagIDs,
-		Name:               i.Name,
-		ShouldMonitor:      i.ShouldMonitor,
-		RootFolderPath:     i.RootFolderPath,
-		SeriesType:         i.SeriesType,
 		BaseURL:            i.BaseURL,
 		APIKey:             i.APIKey,
-		QualityProfileID:   i.QualityProfileID,
-		ID:                 i.ID,
-		EnableAutomaticAdd: i.EnableAutomaticAdd,
-		SeasonFolder:       i.SeasonFolder,
 		ConfigContract:     types.StringValue(importListSonarrConfigContract),
 		Implementation:     types.StringValue(importListSonarrImplementation),
 	}
 }
 
 func (i *ImportListSonarr) fromImportList(importList *ImportList) {
-	i.Tags = importList.Tags
+	i.ImportListBase = importList.ImportListBase
 	i.LanguageProfileIDs = importList.LanguageProfileIDs
 	i.ProfileIDs = importList.ProfileIDs
 	i.TagIDs = importList.TagIDs
-	i.Name = importList.Name
-	i.ShouldMonitor = importList.ShouldMonitor
-	i.RootFolderPath = importList.RootFolderPath
-	i.SeriesType = importList.SeriesType
 	i.BaseURL = importList.BaseURL
 	i.APIKey = importList.APIKey
-	i.QualityProfileID = importList.QualityProfileID
-	i.ID = importList.ID
-	i.EnableAutomaticAdd = importList.EnableAutomaticAdd
-	i.SeasonFolder = importList.SeasonFolder
 }
 
 func (r *ImportListSonarrResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -105,13 +82,21 @@ func (r *ImportListSonarrResource) Schema(_ context.Context, _ resource.SchemaRe
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList Sonarr resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [Sonarr](https://wiki.servarr.com/sonarr/supported#sonarr).",
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_add": schema.BoolAttribute{
-				MarkdownDescription: "Enable automatic add flag.",
-				Required:            true,
+				MarkdownDescription: "Automatically add the series found by the list, when `false` they are only listed for discovery. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
 			},
 			"season_folder": schema.BoolAttribute{
 				MarkdownDescription: "Season folder flag.",
 				Required:            true,
 			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for missing episodes when a series is added. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"quality_profile_id": schema.Int64Attribute{
 				MarkdownDescription: "Quality profile ID.",
 				Required:            true,
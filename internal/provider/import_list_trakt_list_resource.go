@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -42,11 +43,6 @@ type ImportListTraktListResource struct {
 
 // ImportListTraktList describes the import list data model.
 type ImportListTraktList struct {
-	Tags                      types.Set    `tfsdk:"tags"`
-	Name                      types.String `tfsdk:"name"`
-	ShouldMonitor             types.String `tfsdk:"should_monitor"`
-	RootFolderPath            types.String `tfsdk:"root_folder_path"`
-	SeriesType                types.String `tfsdk:"series_type"`
 	AccessToken               types.String `tfsdk:"access_token"`
 	RefreshToken              types.String `tfsdk:"refresh_token"`
 	Expires                   types.String `tfsdk:"expires"`
@@ -54,20 +50,13 @@ type ImportListTraktList struct {
 	Username                  types.String `tfsdk:"username"`
 	Listname                  types.String `tfsdk:"listname"`
 	TraktAdditionalParameters types.String `tfsdk:"trakt_additional_parameters"`
-	QualityProfileID          types.Int64  `tfsdk:"quality_profile_id"`
-	ID                        types.Int64  `tfsdk:"id"`
-	Limit                     types.Int64  `tfsdk:"limit"`
-	EnableAutomaticAdd        types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder              types.Bool   `tfsdk:"season_folder"`
+	ImportListBase
+	Limit types.Int64 `tfsdk:"limit"`
 }
 
 func (i ImportListTraktList) toImportList() *ImportList {
 	return &ImportList{
-		Tags:                      i.Tags,
-		Name:                      i.Name,
-		ShouldMonitor:             i.ShouldMonitor,
-		RootFolderPath:            i.RootFolderPath,
-		SeriesType:                i.SeriesType,
+		ImportListBase:            i.ImportListBase,
 		AccessToken:               i.AccessToken,
 		RefreshToken:              i.RefreshToken,
 		Expires:                   i.Expires,
@@ -76,21 +65,13 @@ func (i ImportListTraktList) toImportList() *ImportList {
 		Listname:                  i.Listname,
 		TraktAdditionalParameters: i.TraktAdditionalParameters,
 		Limit:                     i.Limit,
-		QualityProfileID:          i.QualityProfileID,
-		ID:                        i.ID,
-		EnableAutomaticAdd:        i.EnableAutomaticAdd,
-		SeasonFolder:              i.SeasonFolder,
 		ConfigContract:            types.StringValue(importListTraktListConfigContract),
 		Implementation:            types.StringValue(importListTraktListImplementation),
 	}
 }
 
 func (i *ImportListTraktList) fromImportList(importList *ImportList) {
-	i.Tags = importList.Tags
-	i.Name = importList.Name
-	i.ShouldMonitor = importList.ShouldMonitor
-	i.RootFolderPath = importList.RootFolderPath
-	i.SeriesType = importList.SeriesType
+	i.ImportListBase = importList.ImportListBase
 	i.AccessToken = importList.AccessToken
 	i.RefreshToken = importList.RefreshToken
 	i.Expires = importList.Expires
@@ -99,10 +80,6 @@ func (i *ImportListTraktList) fromImportList(importList *ImportList) {
 	i.Listname = importList.Listname
 	i.TraktAdditionalParameters = importList.TraktAdditionalParameters
 	i.Limit = importList.Limit
-	i.QualityProfileID = importList.QualityProfileID
-	i.ID = importList.ID
-	i.EnableAutomaticAdd = importList.EnableAutomaticAdd
-	i.SeasonFolder = importList.SeasonFolder
 }
 
 func (r *ImportListTraktListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -114,13 +91,21 @@ func (r *ImportListTraktListResource) Schema(_ context.Context, _ resource.Schem
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList TraktList resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [TraktList](https://wiki.servarr.com/sonarr/supported#trakt_list).",
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_add": schema.BoolAttribute{
-				MarkdownDescription: "Enable automatic add flag.",
-				Required:            true,
+				MarkdownDescription: "Automatically add the series found by the list, when `false` they are only listed for discovery. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
 			},
 			"season_folder": schema.BoolAttribute{
 				MarkdownDescription: "Season folder flag.",
 				Required:            true,
 			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for missing episodes when a series is added. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"quality_profile_id": schema.Int64Attribute{
 				MarkdownDescription: "Quality profile ID.",
 				Required:            true,
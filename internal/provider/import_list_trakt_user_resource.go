@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -43,32 +44,20 @@ type ImportListTraktUserResource struct {
 
 // ImportListTraktUser describes the import list data model.
 type ImportListTraktUser struct {
-	Tags                      types.Set    `tfsdk:"tags"`
-	Name                      types.String `tfsdk:"name"`
-	ShouldMonitor             types.String `tfsdk:"should_monitor"`
-	RootFolderPath            types.String `tfsdk:"root_folder_path"`
-	SeriesType                types.String `tfsdk:"series_type"`
 	Username                  types.String `tfsdk:"username"`
 	AccessToken               types.String `tfsdk:"access_token"`
 	RefreshToken              types.String `tfsdk:"refresh_token"`
 	Expires                   types.String `tfsdk:"expires"`
 	AuthUser                  types.String `tfsdk:"auth_user"`
 	TraktAdditionalParameters types.String `tfsdk:"trakt_additional_parameters"`
-	QualityProfileID          types.Int64  `tfsdk:"quality_profile_id"`
-	ID                        types.Int64  `tfsdk:"id"`
-	Limit                     types.Int64  `tfsdk:"limit"`
-	TraktListType             types.Int64  `tfsdk:"trakt_list_type"`
-	EnableAutomaticAdd        types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder              types.Bool   `tfsdk:"season_folder"`
+	ImportListBase
+	Limit         types.Int64 `tfsdk:"limit"`
+	TraktListType types.Int64 `tfsdk:"trakt_list_type"`
 }
 
 func (i ImportListTraktUser) toImportList() *ImportList {
 	return &ImportList{
-		Tags:                      i.Tags,
-		Name:                      i.Name,
-		ShouldMonitor:             i.ShouldMonitor,
-		RootFolderPath:            i.RootFolderPath,
-		SeriesType:                i.SeriesType,
+		ImportListBase:            i.ImportListBase,
 		Username:                  i.Username,
 		AccessToken:               i.AccessToken,
 		RefreshToken:              i.RefreshToken,
@@ -77,21 +66,13 @@ func (i ImportListTraktUser) toImportList() *ImportList {
 		TraktListType:             i.TraktListType,
 		TraktAdditionalParameters: i.TraktAdditionalParameters,
 		Limit:                     i.Limit,
-		QualityProfileID:          i.QualityProfileID,
-		ID:                        i.ID,
-		EnableAutomaticAdd:        i.EnableAutomaticAdd,
-		SeasonFolder:              i.SeasonFolder,
 		ConfigContract:            types.StringValue(importListTraktUserConfigContract),
 		Implementation:            types.StringValue(importListTraktUserImplementation),
 	}
 }
 
 func (i *ImportListTraktUser) fromImportList(importList *ImportList) {
-	i.Tags = importList.Tags
-	i.Name = importList.Name
-	i.ShouldMonitor = importList.ShouldMonitor
-	i.RootFolderPath = importList.RootFolderPath
-	i.SeriesType = importList.SeriesType
+	i.ImportListBase = importList.ImportListBase
 	i.Username = importList.Username
 	i.AccessToken = importList.AccessToken
 	i.RefreshToken = importList.RefreshToken
@@ -100,10 +81,6 @@ func (i *ImportListTraktUser) fromImportList(importList *ImportList) {
 	i.TraktListType = importList.TraktListType
 	i.TraktAdditionalParameters = importList.TraktAdditionalParameters
 	i.Limit = importList.Limit
-	i.QualityProfileID = importList.QualityProfileID
-	i.ID = importList.ID
-	i.EnableAutomaticAdd = importList.EnableAutomaticAdd
-	i.SeasonFolder = importList.SeasonFolder
 }
 
 func (r *ImportListTraktUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -115,13 +92,21 @@ func (r *ImportListTraktUserResource) Schema(_ context.Context, _ resource.Schem
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList TraktUser resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [TraktUser](https://wiki.servarr.com/sonarr/supported#trakt_user).",
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_add": schema.BoolAttribute{
-				MarkdownDescription: "Enable automatic add flag.",
-				Required:            true,
+				MarkdownDescription: "Automatically add the series found by the list, when `false` they are only listed for discovery. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
 			},
 			"season_folder": schema.BoolAttribute{
 				MarkdownDescription: "Season folder flag.",
 				Required:            true,
 			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for missing episodes when a series is added. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"quality_profile_id": schema.Int64Attribute{
 				MarkdownDescription: "Quality profile ID.",
 				Required:            true,
@@ -59,6 +59,10 @@ func (d *ImportListsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 							MarkdownDescription: "Season folder flag.",
 							Computed:            true,
 						},
+						"search_on_add": schema.BoolAttribute{
+							MarkdownDescription: "Search for missing episodes when a series is added.",
+							Computed:            true,
+						},
 						"quality_profile_id": schema.Int64Attribute{
 							MarkdownDescription: "Quality profile ID.",
 							Computed:            true,
@@ -55,6 +55,7 @@ type IndexerBroadcastheNet struct {
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	Test                    types.Bool    `tfsdk:"test"`
 }
 
 func (i IndexerBroadcastheNet) toIndexer() *Indexer {
@@ -114,6 +115,10 @@ func (r *IndexerBroadcastheNetResource) Schema(_ context.Context, _ resource.Sch
 				Optional:            true,
 				Computed:            true,
 			},
+			"test": schema.BoolAttribute{
+				MarkdownDescription: "Test the indexer before saving it. Connectivity or authentication failures are reported as errors. Sonarr already tests indexers on save unless `forceSave` is set.",
+				Optional:            true,
+			},
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -125,9 +130,12 @@ func (r *IndexerBroadcastheNetResource) Schema(_ context.Context, _ resource.Sch
 				Computed:            true,
 			},
 			"download_client_id": schema.Int64Attribute{
-				MarkdownDescription: "Download client ID.",
+				MarkdownDescription: "Download client ID, checked to exist on create. `0` lets Sonarr choose any client.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					helpers.UseStateForUnknownOrZero(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "IndexerBroadcastheNet name.",
@@ -200,6 +208,22 @@ func (r *IndexerBroadcastheNetResource) Create(ctx context.Context, req resource
 	// Create new IndexerBroadcastheNet
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Check the assigned download client exists
+	validateIndexerDownloadClient(r.auth, r.client, request.GetDownloadClientId(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerBroadcastheNetResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerBroadcastheNetResourceName, err))
@@ -250,6 +274,15 @@ func (r *IndexerBroadcastheNetResource) Update(ctx context.Context, req resource
 	// Update IndexerBroadcastheNet
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerBroadcastheNetResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerBroadcastheNetResourceName, err))
@@ -158,6 +158,16 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"multi_languages": schema.SetAttribute{
+				MarkdownDescription: "Language IDs of the multi-language releases.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"required_flags": schema.SetAttribute{
+				MarkdownDescription: "Flags a release must have to be grabbed. `1` Freeleech, `2` Halfleech, `4` DoubleUpload, `8` Internal, `16` Scene, `32` Unknown, `64` Banned.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 		},
 	}
 }
@@ -51,6 +51,7 @@ type IndexerFanzub struct {
 	EnableRss                 types.Bool   `tfsdk:"enable_rss"`
 	EnableInteractiveSearch   types.Bool   `tfsdk:"enable_interactive_search"`
 	EnableAutomaticSearch     types.Bool   `tfsdk:"enable_automatic_search"`
+	Test                      types.Bool   `tfsdk:"test"`
 }
 
 func (i IndexerFanzub) toIndexer() *Indexer {
@@ -102,6 +103,10 @@ func (r *IndexerFanzubResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional:            true,
 				Computed:            true,
 			},
+			"test": schema.BoolAttribute{
+				MarkdownDescription: "Test the indexer before saving it. Connectivity or authentication failures are reported as errors. Sonarr already tests indexers on save unless `forceSave` is set.",
+				Optional:            true,
+			},
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -113,9 +118,12 @@ func (r *IndexerFanzubResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:            true,
 			},
 			"download_client_id": schema.Int64Attribute{
-				MarkdownDescription: "Download client ID.",
+				MarkdownDescription: "Download client ID, checked to exist on create. `0` lets Sonarr choose any client.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					helpers.UseStateForUnknownOrZero(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "IndexerFanzub name.",
@@ -169,6 +177,22 @@ func (r *IndexerFanzubResource) Create(ctx context.Context, req resource.CreateR
 	// Create new IndexerFanzub
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Check the assigned download client exists
+	validateIndexerDownloadClient(r.auth, r.client, request.GetDownloadClientId(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerFanzubResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFanzubResourceName, err))
@@ -219,6 +243,15 @@ func (r *IndexerFanzubResource) Update(ctx context.Context, req resource.UpdateR
 	// Update IndexerFanzub
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerFanzubResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFanzubResourceName, err))
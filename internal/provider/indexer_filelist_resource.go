@@ -58,6 +58,7 @@ type IndexerFilelist struct {
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	Test                    types.Bool    `tfsdk:"test"`
 }
 
 func (i IndexerFilelist) toIndexer() *Indexer {
@@ -123,6 +124,10 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				Optional:            true,
 				Computed:            true,
 			},
+			"test": schema.BoolAttribute{
+				MarkdownDescription: "Test the indexer before saving it. Connectivity or authentication failures are reported as errors. Sonarr already tests indexers on save unless `forceSave` is set.",
+				Optional:            true,
+			},
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -134,9 +139,12 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:            true,
 			},
 			"download_client_id": schema.Int64Attribute{
-				MarkdownDescription: "Download client ID.",
+				MarkdownDescription: "Download client ID, checked to exist on create. `0` lets Sonarr choose any client.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					helpers.UseStateForUnknownOrZero(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "IndexerFilelist name.",
@@ -226,6 +234,22 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 	// Create new IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Check the assigned download client exists
+	validateIndexerDownloadClient(r.auth, r.client, request.GetDownloadClientId(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerFilelistResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFilelistResourceName, err))
@@ -276,6 +300,15 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 	// Update IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerFilelistResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFilelistResourceName, err))
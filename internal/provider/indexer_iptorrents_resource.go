@@ -54,6 +54,7 @@ type IndexerIptorrents struct {
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	Test                    types.Bool    `tfsdk:"test"`
 }
 
 func (i IndexerIptorrents) toIndexer() *Indexer {
@@ -111,6 +112,10 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 				Optional:            true,
 				Computed:            true,
 			},
+			"test": schema.BoolAttribute{
+				MarkdownDescription: "Test the indexer before saving it. Connectivity or authentication failures are reported as errors. Sonarr already tests indexers on save unless `forceSave` is set.",
+				Optional:            true,
+			},
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -122,9 +127,12 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 			},
 			"download_client_id": schema.Int64Attribute{
-				MarkdownDescription: "Download client ID.",
+				MarkdownDescription: "Download client ID, checked to exist on create. `0` lets Sonarr choose any client.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					helpers.UseStateForUnknownOrZero(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "IndexerIptorrents name.",
@@ -192,6 +200,22 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 	// Create new IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Check the assigned download client exists
+	validateIndexerDownloadClient(r.auth, r.client, request.GetDownloadClientId(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerIptorrentsResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerIptorrentsResourceName, err))
@@ -242,6 +266,15 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 	// Update IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerIptorrentsResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerIptorrentsResourceName, err))
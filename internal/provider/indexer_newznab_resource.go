@@ -25,8 +25,9 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerNewznabResource{}
-	_ resource.ResourceWithImportState = &IndexerNewznabResource{}
+	_ resource.Resource                     = &IndexerNewznabResource{}
+	_ resource.ResourceWithImportState      = &IndexerNewznabResource{}
+	_ resource.ResourceWithConfigValidators = &IndexerNewznabResource{}
 )
 
 func NewIndexerNewznabResource() resource.Resource {
@@ -44,6 +45,7 @@ type IndexerNewznab struct {
 	Tags                      types.Set    `tfsdk:"tags"`
 	Categories                types.Set    `tfsdk:"categories"`
 	AnimeCategories           types.Set    `tfsdk:"anime_categories"`
+	MultiLanguages            types.Set    `tfsdk:"multi_languages"`
 	AdditionalParameters      types.String `tfsdk:"additional_parameters"`
 	BaseURL                   types.String `tfsdk:"base_url"`
 	APIPath                   types.String `tfsdk:"api_path"`
@@ -56,6 +58,8 @@ type IndexerNewznab struct {
 	EnableRss                 types.Bool   `tfsdk:"enable_rss"`
 	EnableInteractiveSearch   types.Bool   `tfsdk:"enable_interactive_search"`
 	EnableAutomaticSearch     types.Bool   `tfsdk:"enable_automatic_search"`
+	Test                      types.Bool   `tfsdk:"test"`
+	ValidateCategories        types.Bool   `tfsdk:"validate_categories"`
 }
 
 func (i IndexerNewznab) toIndexer() *Indexer {
@@ -73,6 +77,7 @@ func (i IndexerNewznab) toIndexer() *Indexer {
 		APIPath:                   i.APIPath,
 		BaseURL:                   i.BaseURL,
 		AnimeCategories:           i.AnimeCategories,
+		MultiLanguages:            i.MultiLanguages,
 		Categories:                i.Categories,
 		Tags:                      i.Tags,
 		ConfigContract:            types.StringValue(indexerNewznabConfigContract),
@@ -95,6 +100,7 @@ func (i *IndexerNewznab) fromIndexer(indexer *Indexer) {
 	i.APIPath = indexer.APIPath
 	i.BaseURL = indexer.BaseURL
 	i.AnimeCategories = indexer.AnimeCategories
+	i.MultiLanguages = indexer.MultiLanguages
 	i.Categories = indexer.Categories
 	i.Tags = indexer.Tags
 }
@@ -117,6 +123,10 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Computed:            true,
 			},
+			"test": schema.BoolAttribute{
+				MarkdownDescription: "Test the indexer before saving it. Connectivity or authentication failures are reported as errors. Sonarr already tests indexers on save unless `forceSave` is set.",
+				Optional:            true,
+			},
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -128,9 +138,12 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 			},
 			"download_client_id": schema.Int64Attribute{
-				MarkdownDescription: "Download client ID.",
+				MarkdownDescription: "Download client ID, checked to exist on create. `0` lets Sonarr choose any client.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					helpers.UseStateForUnknownOrZero(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "IndexerNewznab name.",
@@ -182,12 +195,22 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"validate_categories": schema.BoolAttribute{
+				MarkdownDescription: "Warn when `categories` or `anime_categories` are not exposed by the indexer capabilities. The check is skipped if the capabilities cannot be retrieved.",
+				Optional:            true,
+			},
 			"anime_categories": schema.SetAttribute{
 				MarkdownDescription: "Anime list.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"multi_languages": schema.SetAttribute{
+				MarkdownDescription: "Language IDs of the multi-language releases.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 		},
 	}
 }
@@ -199,6 +222,16 @@ func (r *IndexerNewznabResource) Configure(ctx context.Context, req resource.Con
 	}
 }
 
+func (r *IndexerNewznabResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		IndexerCategoriesValidator{
+			client:         func() (context.Context, *sonarr.APIClient) { return r.auth, r.client },
+			implementation: indexerNewznabImplementation,
+			configContract: indexerNewznabConfigContract,
+		},
+	}
+}
+
 func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerNewznab
@@ -212,6 +245,22 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 	// Create new IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Check the assigned download client exists
+	validateIndexerDownloadClient(r.auth, r.client, request.GetDownloadClientId(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerNewznabResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNewznabResourceName, err))
@@ -262,6 +311,15 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 	// Update IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerNewznabResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerNewznabResourceName, err))
@@ -20,12 +20,29 @@ func TestAccIndexerNewznabResource(t *testing.T) {
 				Config:      testAccIndexerNewznabResourceConfig("newzabResourceTest", "false") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Failing indexer test
+			{
+				Config:      testAccIndexerNewznabResourceTestConfig,
+				ExpectError: regexp.MustCompile("Unable to test"),
+			},
+			// Missing download client
+			{
+				Config:      testAccIndexerNewznabResourceDownloadClientConfig,
+				ExpectError: regexp.MustCompile("Download client 9999 cannot be assigned"),
+			},
+			// Categories validation is best-effort
+			{
+				Config:             testAccIndexerNewznabResourceValidateCategoriesConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
 			// Create and Read testing
 			{
 				Config: testAccIndexerNewznabResourceConfig("newzabResourceTest", "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_indexer_newznab.test", "enable_automatic_search", "false"),
 					resource.TestCheckResourceAttr("sonarr_indexer_newznab.test", "base_url", "https://lolo.sickbeard.com"),
+					resource.TestCheckResourceAttr("sonarr_indexer_newznab.test", "multi_languages.#", "2"),
 					resource.TestCheckResourceAttrSet("sonarr_indexer_newznab.test", "id"),
 				),
 			},
@@ -60,5 +77,40 @@ func testAccIndexerNewznabResourceConfig(name, aSearch string) string {
 		base_url = "https://lolo.sickbeard.com"
 		api_path = "/api"
 		categories = [5030, 5040]
+		multi_languages = [1, 2]
 	}`, aSearch, name)
 }
+
+const testAccIndexerNewznabResourceTestConfig = `
+	resource "sonarr_indexer_newznab" "test" {
+		enable_automatic_search = false
+		name = "newzabResourceTest"
+		base_url = "http://127.0.0.1:1"
+		api_path = "/api"
+		categories = [5030, 5040]
+		test = true
+	}
+`
+
+const testAccIndexerNewznabResourceValidateCategoriesConfig = `
+	resource "sonarr_indexer_newznab" "test" {
+		enable_automatic_search = false
+		name = "newzabResourceTest"
+		base_url = "http://127.0.0.1:1"
+		api_path = "/api"
+		categories = [5030, 999999]
+		validate_categories = true
+	}
+`
+
+const testAccIndexerNewznabResourceDownloadClientConfig = `
+	resource "sonarr_indexer_newznab" "test" {
+		enable_automatic_search = false
+		name = "newzabResourceTest"
+		base_url = "https://lolo.sickbeard.com"
+		api_path = "/api"
+		categories = [5030, 5040]
+		priority = 10
+		download_client_id = 9999
+	}
+`
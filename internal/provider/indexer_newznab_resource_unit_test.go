@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnitIndexerNewznabResource_validateCategories(t *testing.T) {
+	t.Parallel()
+
+	serverURL, key, cleanup := testutil.MockSonarrServer(t)
+	t.Cleanup(cleanup)
+
+	auth, client := testUnitAPIClient(t, serverURL, key)
+	r := &IndexerNewznabResource{client: client, auth: auth}
+
+	tests := map[string]struct {
+		warning    string
+		categories []int64
+		validate   bool
+	}{
+		"unknown": {
+			warning:    "[999999]",
+			categories: []int64{5030, 999999},
+			validate:   true,
+		},
+		"known": {
+			categories: []int64{5030, 5040},
+			validate:   true,
+		},
+		"disabled": {
+			categories: []int64{5030, 999999},
+			validate:   false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			req := resource.ValidateConfigRequest{Config: testUnitIndexerNewznabConfig(ctx, t, r, test.categories, test.validate)}
+			resp := &resource.ValidateConfigResponse{}
+
+			for _, validator := range r.ConfigValidators(ctx) {
+				validator.ValidateResource(ctx, req, resp)
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if test.warning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("expected no warnings, got %v", warnings)
+				}
+
+				return
+			}
+
+			if len(warnings) != 1 || warnings[0].Summary() != helpers.ResourceWarning || !strings.Contains(warnings[0].Detail(), test.warning) {
+				t.Fatalf("expected a warning about categories %s, got %v", test.warning, warnings)
+			}
+		})
+	}
+}
+
+// testUnitIndexerNewznabConfig returns a newznab configuration with the given categories.
+func testUnitIndexerNewznabConfig(ctx context.Context, t *testing.T, r *IndexerNewznabResource, categories []int64, validate bool) tfsdk.Config {
+	t.Helper()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	values := make([]attr.Value, len(categories))
+	for i, c := range categories {
+		values[i] = types.Int64Value(c)
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := plan.SetAttribute(ctx, path.Root("base_url"), "https://lolo.sickbeard.com")
+	diags.Append(plan.SetAttribute(ctx, path.Root("api_path"), "/api")...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("categories"), types.SetValueMust(types.Int64Type, values))...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("validate_categories"), validate)...)
+
+	if diags.HasError() {
+		t.Fatalf("unable to build the configuration: %v", diags)
+	}
+
+	return tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}
+}
@@ -25,6 +25,7 @@ func TestAccIndexerNyaaResource(t *testing.T) {
 				Config: testAccIndexerNyaaResourceConfig("nyaaResourceTest", "https://nyaa.org"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_indexer_nyaa.test", "base_url", "https://nyaa.org"),
+					resource.TestCheckResourceAttr("sonarr_indexer_nyaa.test", "anime_standard_format_search", "true"),
 					resource.TestCheckResourceAttrSet("sonarr_indexer_nyaa.test", "id"),
 				),
 			},
@@ -58,5 +59,6 @@ func testAccIndexerNyaaResourceConfig(name, url string) string {
 		name = "%s"
 		base_url = "%s"
 		minimum_seeders = 1
+		anime_standard_format_search = true
 	}`, name, url)
 }
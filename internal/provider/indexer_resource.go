@@ -2,10 +2,14 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -25,10 +29,11 @@ const indexerResourceName = "indexer"
 var (
 	_ resource.Resource                = &IndexerResource{}
 	_ resource.ResourceWithImportState = &IndexerResource{}
+	_ resource.ConfigValidator         = IndexerCategoriesValidator{}
 )
 
 var indexerFields = helpers.Fields{
-	IntSlices:        []string{"categories", "animeCategories"},
+	IntSlices:        []string{"categories", "animeCategories", "multiLanguages", "requiredFlags"},
 	Bools:            []string{"allowZeroSize", "animeStandardFormatSearch", "rankedOnly"},
 	Ints:             []string{"delay", "minimumSeeders", "seasonPackSeedTime", "seedTime"},
 	IntsExceptions:   []string{"seedCriteria.seedTime", "seedCriteria.seasonPackSeedTime"},
@@ -53,6 +58,8 @@ type Indexer struct {
 	Tags                      types.Set     `tfsdk:"tags"`
 	Categories                types.Set     `tfsdk:"categories"`
 	AnimeCategories           types.Set     `tfsdk:"anime_categories"`
+	MultiLanguages            types.Set     `tfsdk:"multi_languages"`
+	RequiredFlags             types.Set     `tfsdk:"required_flags"`
 	APIKey                    types.String  `tfsdk:"api_key"`
 	Username                  types.String  `tfsdk:"username"`
 	ConfigContract            types.String  `tfsdk:"config_contract"`
@@ -86,6 +93,8 @@ func (i Indexer) getType() attr.Type {
 			"tags":                         types.SetType{}.WithElementType(types.Int64Type),
 			"categories":                   types.SetType{}.WithElementType(types.Int64Type),
 			"anime_categories":             types.SetType{}.WithElementType(types.Int64Type),
+			"multi_languages":              types.SetType{}.WithElementType(types.Int64Type),
+			"required_flags":               types.SetType{}.WithElementType(types.Int64Type),
 			"api_path":                     types.StringType,
 			"additional_parameters":        types.StringType,
 			"username":                     types.StringType,
@@ -144,9 +153,12 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:            true,
 			},
 			"download_client_id": schema.Int64Attribute{
-				MarkdownDescription: "Download client ID.",
+				MarkdownDescription: "Download client ID, checked to exist on create. `0` lets Sonarr choose any client.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					helpers.UseStateForUnknownOrZero(),
+				},
 			},
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "Indexer configuration template.",
@@ -275,6 +287,21 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"multi_languages": schema.SetAttribute{
+				MarkdownDescription: "Language IDs of the multi-language releases.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"required_flags": schema.SetAttribute{
+				MarkdownDescription: "Flags a release must have to be grabbed. `1` Freeleech, `2` Halfleech, `4` DoubleUpload, `8` Internal, `16` Scene, `32` Unknown, `64` Banned.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.OneOf(1, 2, 4, 8, 16, 32, 64)),
+				},
+			},
 		},
 	}
 }
@@ -299,6 +326,13 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create new Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Check the assigned download client exists
+	validateIndexerDownloadClient(r.auth, r.client, request.GetDownloadClientId(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
@@ -396,7 +430,16 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *IndexerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), indexerResourceName, req, resp, func() (map[string]int, error) {
+		indexers, _, err := r.client.IndexerAPI.ListIndexer(r.auth).Execute()
+		names := make(map[string]int, len(indexers))
+
+		for _, i := range indexers {
+			names[i.GetName()] = int(i.GetId())
+		}
+
+		return names, err
+	})
 	tflog.Trace(ctx, "imported "+indexerResourceName+": "+req.ID)
 }
 
@@ -418,6 +461,8 @@ func (i *Indexer) write(ctx context.Context, indexer *sonarr.IndexerResource, di
 	i.Protocol = types.StringValue(string(indexer.GetProtocol()))
 	i.AnimeCategories = types.SetValueMust(types.Int64Type, nil)
 	i.Categories = types.SetValueMust(types.Int64Type, nil)
+	i.MultiLanguages = types.SetValueMust(types.Int64Type, nil)
+	i.RequiredFlags = types.SetValueMust(types.Int64Type, nil)
 	helpers.WriteFields(ctx, i, indexer.GetFields(), indexerFields)
 }
 
@@ -449,3 +494,135 @@ func (i *Indexer) writeSensitive(indexer *Indexer) {
 		i.APIKey = indexer.APIKey
 	}
 }
+
+// validateIndexerDownloadClient checks the download client assigned to an indexer exists.
+func validateIndexerDownloadClient(auth context.Context, client *sonarr.APIClient, id int32, diags *diag.Diagnostics) {
+	if id == 0 {
+		return
+	}
+
+	if _, _, err := client.DownloadClientAPI.GetDownloadClientById(auth, id).Execute(); err != nil {
+		diags.AddAttributeError(
+			path.Root("download_client_id"),
+			helpers.ResourceError,
+			fmt.Sprintf("Download client %d cannot be assigned, got error: %s", id, err),
+		)
+	}
+}
+
+// testIndexer runs the Sonarr indexer test, reporting failures as errors of the named resource.
+func testIndexer(auth context.Context, client *sonarr.APIClient, indexer *sonarr.IndexerResource, name string, diags *diag.Diagnostics) {
+	if _, err := client.IndexerAPI.TestIndexer(auth).IndexerResource(*indexer).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.TestAction, name, err))
+	}
+}
+
+// IndexerCategoriesValidator warns about categories not exposed by the indexer capabilities.
+// The check is best-effort: it is skipped when the provider is not configured or the capabilities cannot be retrieved.
+type IndexerCategoriesValidator struct {
+	client         func() (context.Context, *sonarr.APIClient)
+	implementation string
+	configContract string
+}
+
+func (v IndexerCategoriesValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v IndexerCategoriesValidator) MarkdownDescription(_ context.Context) string {
+	return "Categories should be exposed by the indexer capabilities when validate_categories is true."
+}
+
+func (v IndexerCategoriesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var validate types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validate_categories"), &validate)...)
+
+	if resp.Diagnostics.HasError() || !validate.ValueBool() {
+		return
+	}
+
+	// Capabilities can be retrieved only when the provider is configured
+	auth, client := v.client()
+	if client == nil {
+		return
+	}
+
+	indexer := Indexer{
+		Implementation: types.StringValue(v.implementation),
+		ConfigContract: types.StringValue(v.configContract),
+		Tags:           types.SetNull(types.Int64Type),
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("base_url"), &indexer.BaseURL)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_path"), &indexer.APIPath)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &indexer.APIKey)...)
+
+	if resp.Diagnostics.HasError() || indexer.BaseURL.IsNull() || indexer.BaseURL.IsUnknown() {
+		return
+	}
+
+	available, err := v.categories(auth, client, indexer.read(ctx, &resp.Diagnostics))
+	if err != nil {
+		tflog.Debug(ctx, "unable to retrieve indexer capabilities, skipping categories validation: "+err.Error())
+
+		return
+	}
+
+	for _, attribute := range []string{"categories", "anime_categories"} {
+		var categories types.Set
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &categories)...)
+
+		if categories.IsNull() || categories.IsUnknown() {
+			continue
+		}
+
+		var values []int64
+
+		resp.Diagnostics.Append(categories.ElementsAs(ctx, &values, true)...)
+
+		unknown := make([]int64, 0)
+
+		for _, c := range values {
+			if _, ok := available[c]; !ok {
+				unknown = append(unknown, c)
+			}
+		}
+
+		if len(unknown) > 0 {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(attribute),
+				helpers.ResourceWarning,
+				fmt.Sprintf("Categories %v are not exposed by the indexer capabilities, searches on them will return no results", unknown),
+			)
+		}
+	}
+}
+
+// categories returns the category IDs exposed by the indexer capabilities.
+func (v IndexerCategoriesValidator) categories(auth context.Context, client *sonarr.APIClient, indexer *sonarr.IndexerResource) (map[int64]struct{}, error) {
+	httpResp, err := client.IndexerAPI.CreateIndexerActionByName(auth, "newznabCategories").IndexerResource(*indexer).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	defer httpResp.Body.Close()
+
+	var capabilities struct {
+		Options []struct {
+			Value int64 `json:"value"`
+		} `json:"options"`
+	}
+
+	if err = json.NewDecoder(httpResp.Body).Decode(&capabilities); err != nil {
+		return nil, err
+	}
+
+	categories := make(map[int64]struct{}, len(capabilities.Options))
+	for _, o := range capabilities.Options {
+		categories[o.Value] = struct{}{}
+	}
+
+	return categories, nil
+}
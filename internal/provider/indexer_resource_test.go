@@ -20,6 +20,12 @@ func TestAccIndexerResource(t *testing.T) {
 				Config:      testAccIndexerResourceConfig("resourceTest", "false") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Invalid required flags
+			{
+				Config:      testAccIndexerResourceRequiredFlagsConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccIndexerResourceConfig("resourceTest", "false"),
@@ -47,6 +53,13 @@ func TestAccIndexerResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "sonarr_indexer.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFromAttribute("sonarr_indexer.test", "name"),
+				ImportStateVerify: true,
+			},
 			{
 				ResourceName:            "sonarr_indexer.test_sensitive",
 				ImportState:             true,
@@ -86,3 +99,16 @@ func testAccIndexerResourceConfig(name, aSearch string) string {
 	}
 	`, aSearch, name, name)
 }
+
+const testAccIndexerResourceRequiredFlagsConfig = `
+	resource "sonarr_indexer" "test" {
+		name = "requiredFlags"
+		base_url = "https://filelist.io"
+		username = "test"
+		passkey = "Pass"
+		implementation = "FileList"
+		protocol = "torrent"
+		config_contract = "FileListSettings"
+		required_flags = [1, 3]
+	}
+`
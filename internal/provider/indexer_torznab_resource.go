@@ -25,8 +25,9 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerTorznabResource{}
-	_ resource.ResourceWithImportState = &IndexerTorznabResource{}
+	_ resource.Resource                     = &IndexerTorznabResource{}
+	_ resource.ResourceWithImportState      = &IndexerTorznabResource{}
+	_ resource.ResourceWithConfigValidators = &IndexerTorznabResource{}
 )
 
 func NewIndexerTorznabResource() resource.Resource {
@@ -44,6 +45,7 @@ type IndexerTorznab struct {
 	SeedRatio                 types.Float64 `tfsdk:"seed_ratio"`
 	Categories                types.Set     `tfsdk:"categories"`
 	AnimeCategories           types.Set     `tfsdk:"anime_categories"`
+	MultiLanguages            types.Set     `tfsdk:"multi_languages"`
 	Tags                      types.Set     `tfsdk:"tags"`
 	Name                      types.String  `tfsdk:"name"`
 	BaseURL                   types.String  `tfsdk:"base_url"`
@@ -60,6 +62,8 @@ type IndexerTorznab struct {
 	EnableAutomaticSearch     types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss                 types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch   types.Bool    `tfsdk:"enable_interactive_search"`
+	Test                      types.Bool    `tfsdk:"test"`
+	ValidateCategories        types.Bool    `tfsdk:"validate_categories"`
 }
 
 func (i IndexerTorznab) toIndexer() *Indexer {
@@ -83,6 +87,7 @@ func (i IndexerTorznab) toIndexer() *Indexer {
 		Tags:                      i.Tags,
 		Categories:                i.Categories,
 		AnimeCategories:           i.AnimeCategories,
+		MultiLanguages:            i.MultiLanguages,
 		ConfigContract:            types.StringValue(indexerTorznabConfigContract),
 		Implementation:            types.StringValue(indexerTorznabImplementation),
 		Protocol:                  types.StringValue(indexerTorznabProtocol),
@@ -109,6 +114,7 @@ func (i *IndexerTorznab) fromIndexer(indexer *Indexer) {
 	i.Tags = indexer.Tags
 	i.Categories = indexer.Categories
 	i.AnimeCategories = indexer.AnimeCategories
+	i.MultiLanguages = indexer.MultiLanguages
 }
 
 func (r *IndexerTorznabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -129,6 +135,10 @@ func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Computed:            true,
 			},
+			"test": schema.BoolAttribute{
+				MarkdownDescription: "Test the indexer before saving it. Connectivity or authentication failures are reported as errors. Sonarr already tests indexers on save unless `forceSave` is set.",
+				Optional:            true,
+			},
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -140,9 +150,12 @@ func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 			},
 			"download_client_id": schema.Int64Attribute{
-				MarkdownDescription: "Download client ID.",
+				MarkdownDescription: "Download client ID, checked to exist on create. `0` lets Sonarr choose any client.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					helpers.UseStateForUnknownOrZero(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "IndexerTorznab name.",
@@ -213,12 +226,22 @@ func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"validate_categories": schema.BoolAttribute{
+				MarkdownDescription: "Warn when `categories` or `anime_categories` are not exposed by the indexer capabilities. The check is skipped if the capabilities cannot be retrieved.",
+				Optional:            true,
+			},
 			"anime_categories": schema.SetAttribute{
 				MarkdownDescription: "Anime categories list.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"multi_languages": schema.SetAttribute{
+				MarkdownDescription: "Language IDs of the multi-language releases.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 		},
 	}
 }
@@ -230,6 +253,16 @@ func (r *IndexerTorznabResource) Configure(ctx context.Context, req resource.Con
 	}
 }
 
+func (r *IndexerTorznabResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		IndexerCategoriesValidator{
+			client:         func() (context.Context, *sonarr.APIClient) { return r.auth, r.client },
+			implementation: indexerTorznabImplementation,
+			configContract: indexerTorznabConfigContract,
+		},
+	}
+}
+
 func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerTorznab
@@ -243,6 +276,22 @@ func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.Create
 	// Create new IndexerTorznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Check the assigned download client exists
+	validateIndexerDownloadClient(r.auth, r.client, request.GetDownloadClientId(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerTorznabResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorznabResourceName, err))
@@ -293,6 +342,15 @@ func (r *IndexerTorznabResource) Update(ctx context.Context, req resource.Update
 	// Update IndexerTorznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	// Test indexer before saving it
+	if indexer.Test.ValueBool() {
+		testIndexer(r.auth, r.client, request, indexerTorznabResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerTorznabResourceName, err))
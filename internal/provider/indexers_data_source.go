@@ -6,8 +6,10 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -31,6 +33,7 @@ type IndexersDataSource struct {
 type Indexers struct {
 	Indexers types.Set    `tfsdk:"indexers"`
 	ID       types.String `tfsdk:"id"`
+	Protocol types.String `tfsdk:"protocol"`
 }
 
 func (d *IndexersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +49,13 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Return only the indexers using the given protocol. Valid values are 'usenet' and 'torrent'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("usenet", "torrent"),
+				},
+			},
 			"indexers": schema.SetNestedAttribute{
 				MarkdownDescription: "Indexer list.",
 				Computed:            true,
@@ -173,6 +183,16 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"multi_languages": schema.SetAttribute{
+							MarkdownDescription: "Language IDs of the multi-language releases.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"required_flags": schema.SetAttribute{
+							MarkdownDescription: "Flags a release must have to be grabbed. `1` Freeleech, `2` Halfleech, `4` DoubleUpload, `8` Internal, `16` Scene, `32` Unknown, `64` Banned.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
 					},
 				},
 			},
@@ -187,7 +207,15 @@ func (d *IndexersDataSource) Configure(ctx context.Context, req datasource.Confi
 	}
 }
 
-func (d *IndexersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *IndexersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Indexers
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get indexers current value
 	response, _, err := d.client.IndexerAPI.ListIndexer(d.auth).Execute()
 	if err != nil {
@@ -198,12 +226,23 @@ func (d *IndexersDataSource) Read(ctx context.Context, _ datasource.ReadRequest,
 
 	tflog.Trace(ctx, "read "+indexersDataSourceName)
 	// Map response body to resource schema attribute
-	indexers := make([]Indexer, len(response))
-	for i, p := range response {
-		indexers[i].write(ctx, &p, &resp.Diagnostics)
+	indexers := make([]Indexer, 0, len(response))
+
+	for _, p := range response {
+		if !data.Protocol.IsNull() && string(p.GetProtocol()) != data.Protocol.ValueString() {
+			continue
+		}
+
+		var indexer Indexer
+
+		indexer.write(ctx, &p, &resp.Diagnostics)
+		indexers = append(indexers, indexer)
 	}
 
 	indexerList, diags := types.SetValueFrom(ctx, Indexer{}.getType(), indexers)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, Indexers{Indexers: indexerList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+
+	data.Indexers = indexerList
+	data.ID = types.StringValue(strconv.Itoa(len(indexers)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -19,6 +20,11 @@ func TestAccIndexersDataSource(t *testing.T) {
 				Config:      testAccIndexersDataSourceConfig + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Invalid protocol
+			{
+				Config:      testAccIndexersDataSourceProtocolConfig("ftp"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Create a resource to have a value to check
 			{
 				Config: testAccIndexerResourceConfig("datasourceTest", "true"),
@@ -30,6 +36,14 @@ func TestAccIndexersDataSource(t *testing.T) {
 					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_indexers.test", "indexers.*", map[string]string{"protocol": "usenet"}),
 				),
 			},
+			// Filter by protocol
+			{
+				Config: testAccIndexerResourceConfig("datasourceTest", "true") + testAccIndexersDataSourceProtocolConfig("usenet"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_indexers.test", "protocol", "usenet"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_indexers.test", "indexers.*", map[string]string{"name": "datasourceTest"}),
+				),
+			},
 		},
 	})
 }
@@ -38,3 +52,10 @@ const testAccIndexersDataSourceConfig = `
 data "sonarr_indexers" "test" {
 }
 `
+
+func testAccIndexersDataSourceProtocolConfig(protocol string) string {
+	return fmt.Sprintf(`
+	data "sonarr_indexers" "test" {
+		protocol = "%s"
+	}`, protocol)
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const languageProfileDataSourceName = "language_profile"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LanguageProfileDataSource{}
+
+func NewLanguageProfileDataSource() datasource.DataSource {
+	return &LanguageProfileDataSource{}
+}
+
+// LanguageProfileDataSource defines the language profile implementation.
+type LanguageProfileDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// LanguageProfile describes the language profile data model.
+type LanguageProfile struct {
+	Languages      types.Set    `tfsdk:"languages"`
+	Name           types.String `tfsdk:"name"`
+	Cutoff         types.String `tfsdk:"cutoff"`
+	ID             types.Int64  `tfsdk:"id"`
+	UpgradeAllowed types.Bool   `tfsdk:"upgrade_allowed"`
+}
+
+func (d *LanguageProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + languageProfileDataSourceName
+}
+
+func (d *LanguageProfileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Profiles -->\nSingle Language Profile. Only available on Sonarr v3, to be used as [Series](../resources/series) `language_profile_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Language Profile ID.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Language Profile Name.",
+				Required:            true,
+			},
+			"upgrade_allowed": schema.BoolAttribute{
+				MarkdownDescription: "Upgrade allowed flag.",
+				Computed:            true,
+			},
+			"cutoff": schema.StringAttribute{
+				MarkdownDescription: "Name of the language to which cutoff.",
+				Computed:            true,
+			},
+			"languages": schema.SetAttribute{
+				MarkdownDescription: "Names of the allowed languages.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *LanguageProfileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *LanguageProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *LanguageProfile
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get language profiles current value
+	response, _, err := d.client.LanguageProfileAPI.ListLanguageProfile(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, languageProfileDataSourceName, err))
+
+		return
+	}
+
+	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	tflog.Trace(ctx, "read "+languageProfileDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *LanguageProfile) write(ctx context.Context, profile *sonarr.LanguageProfileResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	languages := make([]string, 0, len(profile.GetLanguages()))
+
+	for _, l := range profile.GetLanguages() {
+		if l.GetAllowed() {
+			languages = append(languages, l.Language.GetName())
+		}
+	}
+
+	p.ID = types.Int64Value(int64(profile.GetId()))
+	p.Name = types.StringValue(profile.GetName())
+	p.UpgradeAllowed = types.BoolValue(profile.GetUpgradeAllowed())
+	p.Cutoff = types.StringValue(profile.Cutoff.GetName())
+	p.Languages, tempDiag = types.SetValueFrom(ctx, types.StringType, languages)
+	diags.Append(tempDiag...)
+}
+
+func (p *LanguageProfile) find(ctx context.Context, name string, profiles []sonarr.LanguageProfileResource, diags *diag.Diagnostics) {
+	for _, profile := range profiles {
+		if profile.GetName() == name {
+			p.write(ctx, &profile, diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(languageProfileDataSourceName, "name", name))
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLanguageProfileDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckVersion(t, "3") },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccLanguageProfileDataSourceConfig("English") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccLanguageProfileDataSourceConfig("Error"),
+				ExpectError: regexp.MustCompile("Unable to find language_profile"),
+			},
+			// Read testing
+			{
+				Config: testAccLanguageProfileDataSourceConfig("English"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_language_profile.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_language_profile.test", "cutoff", "English"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLanguageProfileDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+	data "sonarr_language_profile" "test" {
+		name = "%s"
+	}
+	`, name)
+}
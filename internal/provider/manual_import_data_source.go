@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const manualImportDataSourceName = "manual_import"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ManualImportDataSource{}
+
+func NewManualImportDataSource() datasource.DataSource {
+	return &ManualImportDataSource{}
+}
+
+// ManualImportDataSource defines the manual import implementation.
+type ManualImportDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// ManualImport describes the manual import data model.
+type ManualImport struct {
+	Files  types.List   `tfsdk:"files"`
+	ID     types.String `tfsdk:"id"`
+	Folder types.String `tfsdk:"folder"`
+}
+
+// ManualImportFile is part of ManualImport.
+type ManualImportFile struct {
+	EpisodeNumbers types.List   `tfsdk:"episode_numbers"`
+	Languages      types.List   `tfsdk:"languages"`
+	Rejections     types.List   `tfsdk:"rejections"`
+	Path           types.String `tfsdk:"path"`
+	SeriesTitle    types.String `tfsdk:"series_title"`
+	Quality        types.String `tfsdk:"quality"`
+	Size           types.Int64  `tfsdk:"size"`
+	SeriesID       types.Int64  `tfsdk:"series_id"`
+	SeasonNumber   types.Int64  `tfsdk:"season_number"`
+}
+
+func (f ManualImportFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"episode_numbers": types.ListType{}.WithElementType(types.Int64Type),
+			"languages":       types.ListType{}.WithElementType(types.StringType),
+			"rejections":      types.ListType{}.WithElementType(types.StringType),
+			"path":            types.StringType,
+			"series_title":    types.StringType,
+			"quality":         types.StringType,
+			"size":            types.Int64Type,
+			"series_id":       types.Int64Type,
+			"season_number":   types.Int64Type,
+		})
+}
+
+func (d *ManualImportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + manualImportDataSourceName
+}
+
+func (d *ManualImportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Media Management -->\nList the files of a folder as Sonarr would import them, without importing them.\nFor more information refer to [Manual Import](https://wiki.servarr.com/sonarr/activity#manual-import) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "Folder to scan.",
+				Required:            true,
+			},
+			"files": schema.ListNestedAttribute{
+				MarkdownDescription: "Import candidates.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "File path.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"series_id": schema.Int64Attribute{
+							MarkdownDescription: "Detected series ID, `0` if none.",
+							Computed:            true,
+						},
+						"series_title": schema.StringAttribute{
+							MarkdownDescription: "Detected series title.",
+							Computed:            true,
+						},
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Detected season number.",
+							Computed:            true,
+						},
+						"episode_numbers": schema.ListAttribute{
+							MarkdownDescription: "Detected episode numbers.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"quality": schema.StringAttribute{
+							MarkdownDescription: "Detected quality name.",
+							Computed:            true,
+						},
+						"languages": schema.ListAttribute{
+							MarkdownDescription: "Detected language names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"rejections": schema.ListAttribute{
+							MarkdownDescription: "Rejection reasons, empty if the file can be imported.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ManualImportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *ManualImportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ManualImport
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get manual import current value
+	response, _, err := d.client.ManualImportAPI.ListManualImport(d.auth).Folder(data.Folder.ValueString()).FilterExistingFiles(true).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, manualImportDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+manualImportDataSourceName)
+	// Map response body to resource schema attribute
+	files := make([]ManualImportFile, len(response))
+	for i, f := range response {
+		files[i].write(ctx, &f, &resp.Diagnostics)
+	}
+
+	fileList, diags := types.ListValueFrom(ctx, ManualImportFile{}.getType(), files)
+	resp.Diagnostics.Append(diags...)
+
+	data.Files = fileList
+	data.ID = types.StringValue(strconv.Itoa(len(files)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (f *ManualImportFile) write(ctx context.Context, file *sonarr.ManualImportResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	series := file.GetSeries()
+	quality := file.GetQuality()
+	qualityInfo := quality.GetQuality()
+
+	episodes := make([]int32, len(file.GetEpisodes()))
+	for i, e := range file.GetEpisodes() {
+		episodes[i] = e.GetEpisodeNumber()
+	}
+
+	languages := make([]string, len(file.GetLanguages()))
+	for i, l := range file.GetLanguages() {
+		languages[i] = l.GetName()
+	}
+
+	rejections := make([]string, len(file.GetRejections()))
+	for i, r := range file.GetRejections() {
+		rejections[i] = r.GetReason()
+	}
+
+	f.Path = types.StringValue(file.GetPath())
+	f.Size = types.Int64Value(file.GetSize())
+	f.SeriesID = types.Int64Value(int64(series.GetId()))
+	f.SeriesTitle = types.StringValue(series.GetTitle())
+	f.SeasonNumber = types.Int64Value(int64(file.GetSeasonNumber()))
+	f.Quality = types.StringValue(qualityInfo.GetName())
+	f.EpisodeNumbers, tempDiag = types.ListValueFrom(ctx, types.Int64Type, episodes)
+	diags.Append(tempDiag...)
+	f.Languages, tempDiag = types.ListValueFrom(ctx, types.StringType, languages)
+	diags.Append(tempDiag...)
+	f.Rejections, tempDiag = types.ListValueFrom(ctx, types.StringType, rejections)
+	diags.Append(tempDiag...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccManualImportDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccManualImportDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccManualImportDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_manual_import.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_manual_import.test", "files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccManualImportDataSourceConfig = `
+data "sonarr_manual_import" "test" {
+	folder = "/tmp"
+}
+`
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -106,8 +107,11 @@ func (r *MediaManagementResource) Schema(_ context.Context, _ resource.SchemaReq
 				Required:            true,
 			},
 			"minimum_free_space": schema.Int64Attribute{
-				MarkdownDescription: "Minimum free space in MB to allow import.",
+				MarkdownDescription: "Minimum free space in MB to allow import. Must be at least `100`.",
 				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
 			},
 			"recycle_bin_days": schema.Int64Attribute{
 				MarkdownDescription: "Recyle bin days of retention.",
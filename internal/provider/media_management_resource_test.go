@@ -15,14 +15,20 @@ func TestAccMediaManagementResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Minimum free space too low
+			{
+				Config:      testAccMediaManagementResourceConfig("none", 50),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be at least 100"),
+			},
 			// Unauthorized Create
 			{
-				Config:      testAccMediaManagementResourceConfig("none") + testUnauthorizedProvider,
+				Config:      testAccMediaManagementResourceConfig("none", 100) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Create and Read testing
 			{
-				Config: testAccMediaManagementResourceConfig("none"),
+				Config: testAccMediaManagementResourceConfig("none", 100),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_media_management.test", "file_date", "none"),
 					resource.TestCheckResourceAttrSet("sonarr_media_management.test", "id"),
@@ -30,14 +36,15 @@ func TestAccMediaManagementResource(t *testing.T) {
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccMediaManagementResourceConfig("none") + testUnauthorizedProvider,
+				Config:      testAccMediaManagementResourceConfig("none", 100) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccMediaManagementResourceConfig("localAirDate"),
+				Config: testAccMediaManagementResourceConfig("localAirDate", 500),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_media_management.test", "file_date", "localAirDate"),
+					resource.TestCheckResourceAttr("sonarr_media_management.test", "minimum_free_space", "500"),
 				),
 			},
 			// ImportState testing
@@ -51,7 +58,7 @@ func TestAccMediaManagementResource(t *testing.T) {
 	})
 }
 
-func testAccMediaManagementResourceConfig(date string) string {
+func testAccMediaManagementResourceConfig(date string, freeSpace int) string {
 	return fmt.Sprintf(`
 	resource "sonarr_media_management" "test" {
 		unmonitor_previous_episodes = true
@@ -62,7 +69,7 @@ func testAccMediaManagementResourceConfig(date string) string {
 		import_extra_files          = true
 		set_permissions             = true
 		skip_free_space_check       = true
-		minimum_free_space          = 100
+		minimum_free_space          = %d
 		recycle_bin_days            = 7
 		chmod_folder                = "755"
 		chown_group                 = "arrs"
@@ -72,5 +79,5 @@ func testAccMediaManagementResourceConfig(date string) string {
 		file_date                   = "%s"
 		recycle_bin_path            = ""
 		rescan_after_refresh        = "always"
-	}`, date)
+	}`, freeSpace, date)
 }
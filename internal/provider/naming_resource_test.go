@@ -17,27 +17,42 @@ func TestAccNamingResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Unauthorized Create
 			{
-				Config:      testAccNamingResourceConfig("Specials") + testUnauthorizedProvider,
+				Config:      testAccNamingResourceConfig("Specials", 0, 4) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Invalid multi episode style
+			{
+				Config:      testAccNamingResourceConfig("Specials", 6, 4),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Invalid colon replacement format
+			{
+				Config:      testAccNamingResourceConfig("Specials", 0, 5),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Create and Read testing
 			{
-				Config: testAccNamingResourceConfig("Specials"),
+				Config: testAccNamingResourceConfig("Specials", 0, 4),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_naming.test", "specials_folder_format", "Specials"),
+					resource.TestCheckResourceAttr("sonarr_naming.test", "season_folder_format", "Season {season}"),
 					resource.TestCheckResourceAttrSet("sonarr_naming.test", "id"),
 				),
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccNamingResourceConfig("Specials") + testUnauthorizedProvider,
+				Config:      testAccNamingResourceConfig("Specials", 0, 4) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccNamingResourceConfig("S0"),
+				Config: testAccNamingResourceConfig("S0", 5, 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_naming.test", "specials_folder_format", "S0"),
+					resource.TestCheckResourceAttr("sonarr_naming.test", "multi_episode_style", "5"),
+					resource.TestCheckResourceAttr("sonarr_naming.test", "colon_replacement_format", "1"),
 				),
 			},
 			// ImportState testing
@@ -51,18 +66,18 @@ func TestAccNamingResource(t *testing.T) {
 	})
 }
 
-func testAccNamingResourceConfig(specials string) string {
+func testAccNamingResourceConfig(specials string, style, colon int) string {
 	return fmt.Sprintf(`
 	resource "sonarr_naming" "test" {
 		rename_episodes            = true
 		replace_illegal_characters = true
-		multi_episode_style        = 0
-		colon_replacement_format   = 4
+		multi_episode_style        = %d
+		colon_replacement_format   = %d
 		daily_episode_format       = "{Series Title} - {Air-Date} - {Episode Title} {Quality Full}"
 		anime_episode_format       = "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}"
 		series_folder_format       = "{Series Title}"
 		season_folder_format       = "Season {season}"
 		specials_folder_format     = "%s"
 		standard_episode_format    = "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}"
-	}`, specials)
+	}`, style, colon, specials)
 }
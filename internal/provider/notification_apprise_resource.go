@@ -42,61 +42,43 @@ type NotificationAppriseResource struct {
 
 // NotificationApprise describes the notification data model.
 type NotificationApprise struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	FieldTags                     types.Set    `tfsdk:"field_tags"`
-	Name                          types.String `tfsdk:"name"`
-	StatelessURLs                 types.String `tfsdk:"stateless_urls"`
-	ServerURL                     types.String `tfsdk:"server_url"`
-	AuthUsername                  types.String `tfsdk:"auth_username"`
-	AuthPassword                  types.String `tfsdk:"auth_password"`
-	ConfigurationKey              types.String `tfsdk:"configuration_key"`
-	NotificationType              types.Int64  `tfsdk:"notification_type"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	FieldTags        types.Set    `tfsdk:"field_tags"`
+	StatelessURLs    types.String `tfsdk:"stateless_urls"`
+	ServerURL        types.String `tfsdk:"server_url"`
+	AuthUsername     types.String `tfsdk:"auth_username"`
+	AuthPassword     types.String `tfsdk:"auth_password"`
+	ConfigurationKey types.String `tfsdk:"configuration_key"`
+	NotificationBase
+	NotificationType            types.Int64 `tfsdk:"notification_type"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationApprise) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		FieldTags:                     n.FieldTags,
-		StatelessURLs:                 n.StatelessURLs,
-		ServerURL:                     n.ServerURL,
-		AuthUsername:                  n.AuthUsername,
-		AuthPassword:                  n.AuthPassword,
-		ConfigurationKey:              n.ConfigurationKey,
-		NotificationType:              n.NotificationType,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationAppriseConfigContract),
-		Implementation:                types.StringValue(notificationAppriseImplementation),
+		NotificationBase:            n.NotificationBase,
+		FieldTags:                   n.FieldTags,
+		StatelessURLs:               n.StatelessURLs,
+		ServerURL:                   n.ServerURL,
+		AuthUsername:                n.AuthUsername,
+		AuthPassword:                n.AuthPassword,
+		ConfigurationKey:            n.ConfigurationKey,
+		NotificationType:            n.NotificationType,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationAppriseConfigContract),
+		Implementation:              types.StringValue(notificationAppriseImplementation),
 	}
 }
 
 func (n *NotificationApprise) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.FieldTags = notification.FieldTags
 	n.StatelessURLs = notification.StatelessURLs
 	n.ServerURL = notification.ServerURL
@@ -104,20 +86,11 @@ func (n *NotificationApprise) fromNotification(notification *Notification) {
 	n.AuthPassword = notification.AuthPassword
 	n.ConfigurationKey = notification.ConfigurationKey
 	n.NotificationType = notification.NotificationType
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationAppriseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -137,6 +110,18 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
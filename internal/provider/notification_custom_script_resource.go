@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
@@ -40,70 +41,43 @@ type NotificationCustomScriptResource struct {
 
 // NotificationCustomScript describes the notification data model.
 type NotificationCustomScript struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Arguments                     types.String `tfsdk:"arguments"`
-	Path                          types.String `tfsdk:"path"`
-	Name                          types.String `tfsdk:"name"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	Arguments types.String `tfsdk:"arguments"`
+	Path      types.String `tfsdk:"path"`
+	NotificationBase
+	OnGrab                      types.Bool `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool `tfsdk:"on_manual_interaction_required"`
+	OnRename                    types.Bool `tfsdk:"on_rename"`
 }
 
 func (n NotificationCustomScript) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		Path:                          n.Path,
-		Arguments:                     n.Arguments,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnRename:                      n.OnRename,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationCustomScriptConfigContract),
-		Implementation:                types.StringValue(notificationCustomScriptImplementation),
+		NotificationBase:            n.NotificationBase,
+		Path:                        n.Path,
+		Arguments:                   n.Arguments,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		OnRename:                    n.OnRename,
+		ConfigContract:              types.StringValue(notificationCustomScriptConfigContract),
+		Implementation:              types.StringValue(notificationCustomScriptImplementation),
 	}
 }
 
 func (n *NotificationCustomScript) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.Path = notification.Path
 	n.Arguments = notification.Arguments
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
 	n.OnRename = notification.OnRename
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationCustomScriptResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -123,6 +97,18 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
@@ -198,12 +184,12 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 			},
 			// Field values
 			"arguments": schema.StringAttribute{
-				MarkdownDescription: "Arguments.",
+				MarkdownDescription: "Arguments to pass to the script.",
 				Optional:            true,
 				Computed:            true,
 			},
 			"path": schema.StringAttribute{
-				MarkdownDescription: "Path.",
+				MarkdownDescription: "Path of the script, it must exist and be executable by Sonarr.",
 				Required:            true,
 			},
 		},
@@ -232,7 +218,7 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationCustomScriptResourceName, err))
+		addCustomScriptError(helpers.Create, err, &resp.Diagnostics)
 
 		return
 	}
@@ -282,7 +268,7 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationCustomScriptResourceName, err))
+		addCustomScriptError(helpers.Update, err, &resp.Diagnostics)
 
 		return
 	}
@@ -328,3 +314,14 @@ func (n *NotificationCustomScript) write(ctx context.Context, notification *sona
 func (n *NotificationCustomScript) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.NotificationResource {
 	return n.toNotification().read(ctx, diags)
 }
+
+// addCustomScriptError reports path validation failures on the path attribute, as they are the most common issue.
+func addCustomScriptError(action string, err error, diags *diag.Diagnostics) {
+	if message, ok := helpers.ParseValidationError(err, "path"); ok {
+		diags.AddAttributeError(path.Root("path"), helpers.ValidationError, fmt.Sprintf("Unable to %s %s, invalid script path: %s", action, notificationCustomScriptResourceName, message))
+
+		return
+	}
+
+	diags.AddError(helpers.ClientError, helpers.ParseClientError(action, notificationCustomScriptResourceName, err))
+}
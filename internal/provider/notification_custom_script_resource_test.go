@@ -20,6 +20,11 @@ func TestAccNotificationCustomScriptResource(t *testing.T) {
 				Config:      testAccNotificationCustomScriptResourceConfig("resourceScriptTest", "false") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Missing script
+			{
+				Config:      testAccNotificationCustomScriptResourceMissingConfig,
+				ExpectError: regexp.MustCompile("invalid script path"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccNotificationCustomScriptResourceConfig("resourceScriptTest", "false"),
@@ -70,3 +75,11 @@ func testAccNotificationCustomScriptResourceConfig(name, upgrade string) string
 		path = "/scripts/test.sh"
 	}`, upgrade, name)
 }
+
+const testAccNotificationCustomScriptResourceMissingConfig = `
+	resource "sonarr_notification_custom_script" "test" {
+		name      = "resourceScriptMissing"
+		path      = "/scripts/missing.sh"
+		arguments = "--verbose"
+	}
+`
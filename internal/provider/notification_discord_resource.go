@@ -6,12 +6,15 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -40,82 +43,55 @@ type NotificationDiscordResource struct {
 
 // NotificationDiscord describes the notification data model.
 type NotificationDiscord struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	ImportFields                  types.Set    `tfsdk:"import_fields"`
-	GrabFields                    types.Set    `tfsdk:"grab_fields"`
-	WebHookURL                    types.String `tfsdk:"web_hook_url"`
-	Name                          types.String `tfsdk:"name"`
-	Username                      types.String `tfsdk:"username"`
-	Avatar                        types.String `tfsdk:"avatar"`
-	Author                        types.String `tfsdk:"author"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	ImportFields types.Set    `tfsdk:"import_fields"`
+	GrabFields   types.Set    `tfsdk:"grab_fields"`
+	WebHookURL   types.String `tfsdk:"web_hook_url"`
+	Username     types.String `tfsdk:"username"`
+	Avatar       types.String `tfsdk:"avatar"`
+	Author       types.String `tfsdk:"author"`
+	NotificationBase
+	OnGrab                      types.Bool `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool `tfsdk:"on_manual_interaction_required"`
+	OnRename                    types.Bool `tfsdk:"on_rename"`
 }
 
 func (n NotificationDiscord) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		ImportFields:                  n.ImportFields,
-		GrabFields:                    n.GrabFields,
-		WebHookURL:                    n.WebHookURL,
-		Avatar:                        n.Avatar,
-		Username:                      n.Username,
-		Author:                        n.Author,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnRename:                      n.OnRename,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationDiscordConfigContract),
-		Implementation:                types.StringValue(notificationDiscordImplementation),
+		NotificationBase:            n.NotificationBase,
+		ImportFields:                n.ImportFields,
+		GrabFields:                  n.GrabFields,
+		WebHookURL:                  n.WebHookURL,
+		Avatar:                      n.Avatar,
+		Username:                    n.Username,
+		Author:                      n.Author,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		OnRename:                    n.OnRename,
+		ConfigContract:              types.StringValue(notificationDiscordConfigContract),
+		Implementation:              types.StringValue(notificationDiscordImplementation),
 	}
 }
 
 func (n *NotificationDiscord) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.GrabFields = notification.GrabFields
 	n.ImportFields = notification.ImportFields
 	n.WebHookURL = notification.WebHookURL
 	n.Avatar = notification.Avatar
 	n.Username = notification.Username
 	n.Author = notification.Author
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
 	n.OnRename = notification.OnRename
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationDiscordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -135,6 +111,18 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
@@ -233,12 +221,18 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.Between(0, 9)),
+				},
 			},
 			"import_fields": schema.SetAttribute{
 				MarkdownDescription: "Import fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Codecs, `5` Group, `6` Size, `7` Languages, `8` Subtitles, `9` Links, `10` Release, `11` Poster, `12` Fanart.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.Between(0, 12)),
+				},
 			},
 		},
 	}
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/terraform-provider-sonarr/internal/testutil"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -20,12 +21,20 @@ func TestAccNotificationDiscordResource(t *testing.T) {
 				Config:      testAccNotificationDiscordResourceConfig("resourceDiscordTest", "dog-picture") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Invalid fields
+			{
+				Config:      testAccNotificationDiscordResourceInvalidFieldsConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("value must be between"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccNotificationDiscordResourceConfig("resourceDiscordTest", "dog-picture"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_notification_discord.test", "avatar", "dog-picture"),
-					resource.TestCheckResourceAttrSet("sonarr_notification_discord.test", "id"),
+					resource.TestCheckResourceAttr("sonarr_notification_discord.test", "username", "User"),
+					resource.TestCheckResourceAttr("sonarr_notification_discord.test", "grab_fields.#", "10"),
+					testutil.CheckNotificationExists("sonarr_notification_discord.test"),
 				),
 			},
 			// Unauthorized Read
@@ -52,25 +61,17 @@ func TestAccNotificationDiscordResource(t *testing.T) {
 }
 
 func testAccNotificationDiscordResourceConfig(name, avatar string) string {
-	return fmt.Sprintf(`
+	return testutil.NotificationTestConfig(name, "Discord", map[string]string{
+		"avatar":        fmt.Sprintf("%q", avatar),
+		"grab_fields":   "[0,1,2,3,4,5,6,7,8,9]",
+		"import_fields": "[0,1,2,3,4,5,6,7,8,9,10,11]",
+	})
+}
+
+const testAccNotificationDiscordResourceInvalidFieldsConfig = `
 	resource "sonarr_notification_discord" "test" {
-		on_grab                            = false
-		on_download                        = false
-		on_upgrade                         = false
-		on_rename                          = false
-		on_series_delete                   = false
-		on_episode_file_delete             = false
-		on_episode_file_delete_for_upgrade = false
-		on_health_issue                    = false
-		on_application_update              = false
-	  
-		include_health_warnings = false
-		name                    = "%s"
-	  
+		name         = "resourceDiscordInvalid"
 		web_hook_url = "http://discord-web-hook.com"
-		username = "User"
-		avatar = "%s"
-		grab_fields = [0,1,2,3,4,5,6,7,8,9]
-		import_fields = [0,1,2,3,4,5,6,7,8,9,10,11]
-	}`, name, avatar)
-}
+		grab_fields  = [0, 10]
+	}
+`
@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -42,65 +43,47 @@ type NotificationEmailResource struct {
 
 // NotificationEmail describes the notification data model.
 type NotificationEmail struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	To                            types.Set    `tfsdk:"to"`
-	Cc                            types.Set    `tfsdk:"cc"`
-	Bcc                           types.Set    `tfsdk:"bcc"`
-	From                          types.String `tfsdk:"from"`
-	Server                        types.String `tfsdk:"server"`
-	Name                          types.String `tfsdk:"name"`
-	Username                      types.String `tfsdk:"username"`
-	Password                      types.String `tfsdk:"password"`
-	ID                            types.Int64  `tfsdk:"id"`
-	Port                          types.Int64  `tfsdk:"port"`
-	UseEncryption                 types.Int64  `tfsdk:"use_encryption"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	To       types.Set    `tfsdk:"to"`
+	Cc       types.Set    `tfsdk:"cc"`
+	Bcc      types.Set    `tfsdk:"bcc"`
+	From     types.String `tfsdk:"from"`
+	Server   types.String `tfsdk:"server"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	NotificationBase
+	Port                        types.Int64 `tfsdk:"port"`
+	UseEncryption               types.Int64 `tfsdk:"use_encryption"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationEmail) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		From:                          n.From,
-		To:                            n.To,
-		Cc:                            n.Cc,
-		Bcc:                           n.Bcc,
-		Server:                        n.Server,
-		Port:                          n.Port,
-		Username:                      n.Username,
-		Password:                      n.Password,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		UseEncryption:                 n.UseEncryption,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationEmailConfigContract),
-		Implementation:                types.StringValue(notificationEmailImplementation),
+		NotificationBase:            n.NotificationBase,
+		From:                        n.From,
+		To:                          n.To,
+		Cc:                          n.Cc,
+		Bcc:                         n.Bcc,
+		Server:                      n.Server,
+		Port:                        n.Port,
+		Username:                    n.Username,
+		Password:                    n.Password,
+		UseEncryption:               n.UseEncryption,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationEmailConfigContract),
+		Implementation:              types.StringValue(notificationEmailImplementation),
 	}
 }
 
 func (n *NotificationEmail) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.From = notification.From
 	n.To = notification.To
 	n.Cc = notification.Cc
@@ -109,21 +92,12 @@ func (n *NotificationEmail) fromNotification(notification *Notification) {
 	n.Port = notification.Port
 	n.Username = notification.Username
 	n.Password = notification.Password
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.UseEncryption = notification.UseEncryption
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationEmailResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -143,6 +117,18 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
@@ -248,6 +234,9 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: "To.",
 				Required:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
 			},
 			"cc": schema.SetAttribute{
 				MarkdownDescription: "Cc.",
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/terraform-provider-sonarr/internal/testutil"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -20,12 +21,18 @@ func TestAccNotificationEmailResource(t *testing.T) {
 				Config:      testAccNotificationEmailResourceConfig("resourceEmailTest", "test@email.com") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Missing recipients
+			{
+				Config:      testAccNotificationEmailResourceNoRecipientConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("set must contain at least 1 elements"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccNotificationEmailResourceConfig("resourceEmailTest", "test@email.com"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_notification_email.test", "from", "test@email.com"),
-					resource.TestCheckResourceAttrSet("sonarr_notification_email.test", "id"),
+					testutil.CheckNotificationExists("sonarr_notification_email.test"),
 				),
 			},
 			// Unauthorized Read
@@ -52,24 +59,19 @@ func TestAccNotificationEmailResource(t *testing.T) {
 }
 
 func testAccNotificationEmailResourceConfig(name, from string) string {
-	return fmt.Sprintf(`
-	resource "sonarr_notification_email" "test" {
-		on_grab                            = false
-		on_download                        = false
-		on_upgrade                         = false
-		on_series_delete                   = false
-		on_episode_file_delete             = false
-		on_episode_file_delete_for_upgrade = false
-		on_health_issue                    = false
-		on_application_update              = false
-
-		include_health_warnings = false
-		name                    = "%s"
+	return testutil.NotificationTestConfig(name, "Email", map[string]string{
+		"from":           fmt.Sprintf("%q", from),
+		"to":             `["test@test.com", "test1@test.com"]`,
+		"use_encryption": "0",
+	})
+}
 
+const testAccNotificationEmailResourceNoRecipientConfig = `
+	resource "sonarr_notification_email" "test" {
+		name   = "resourceEmailNoRecipient"
 		server = "http://email-server.net"
-		port = 587
-		from = "%s"
-		to = ["test@test.com", "test1@test.com"]
-		use_encryption = 0
-	}`, name, from)
-}
+		port   = 587
+		from   = "test@email.com"
+		to     = []
+	}
+`
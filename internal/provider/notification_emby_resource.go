@@ -40,79 +40,52 @@ type NotificationEmbyResource struct {
 
 // NotificationEmby describes the notification data model.
 type NotificationEmby struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Host                          types.String `tfsdk:"host"`
-	APIKey                        types.String `tfsdk:"api_key"`
-	Name                          types.String `tfsdk:"name"`
-	ID                            types.Int64  `tfsdk:"id"`
-	Port                          types.Int64  `tfsdk:"port"`
-	UpdateLibrary                 types.Bool   `tfsdk:"update_library"`
-	Notify                        types.Bool   `tfsdk:"notify"`
-	UseSSL                        types.Bool   `tfsdk:"use_ssl"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	Host   types.String `tfsdk:"host"`
+	APIKey types.String `tfsdk:"api_key"`
+	NotificationBase
+	Port                types.Int64 `tfsdk:"port"`
+	UpdateLibrary       types.Bool  `tfsdk:"update_library"`
+	Notify              types.Bool  `tfsdk:"notify"`
+	UseSSL              types.Bool  `tfsdk:"use_ssl"`
+	OnGrab              types.Bool  `tfsdk:"on_grab"`
+	OnApplicationUpdate types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue       types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored    types.Bool  `tfsdk:"on_health_restored"`
+	OnRename            types.Bool  `tfsdk:"on_rename"`
 }
 
 func (n NotificationEmby) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		Host:                          n.Host,
-		Name:                          n.Name,
-		APIKey:                        n.APIKey,
-		ID:                            n.ID,
-		Port:                          n.Port,
-		UpdateLibrary:                 n.UpdateLibrary,
-		Notify:                        n.Notify,
-		UseSSL:                        n.UseSSL,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnRename:                      n.OnRename,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationEmbyConfigContract),
-		Implementation:                types.StringValue(notificationEmbyImplementation),
+		NotificationBase:    n.NotificationBase,
+		Host:                n.Host,
+		APIKey:              n.APIKey,
+		Port:                n.Port,
+		UpdateLibrary:       n.UpdateLibrary,
+		Notify:              n.Notify,
+		UseSSL:              n.UseSSL,
+		OnGrab:              n.OnGrab,
+		OnApplicationUpdate: n.OnApplicationUpdate,
+		OnHealthIssue:       n.OnHealthIssue,
+		OnHealthRestored:    n.OnHealthRestored,
+		OnRename:            n.OnRename,
+		ConfigContract:      types.StringValue(notificationEmbyConfigContract),
+		Implementation:      types.StringValue(notificationEmbyImplementation),
 	}
 }
 
 func (n *NotificationEmby) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.Host = notification.Host
-	n.Name = notification.Name
 	n.APIKey = notification.APIKey
-	n.ID = notification.ID
 	n.UpdateLibrary = notification.UpdateLibrary
 	n.Port = notification.Port
 	n.Notify = notification.Notify
 	n.UseSSL = notification.UseSSL
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
 	n.OnRename = notification.OnRename
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationEmbyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -132,6 +105,18 @@ func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRe
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
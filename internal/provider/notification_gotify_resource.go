@@ -42,70 +42,43 @@ type NotificationGotifyResource struct {
 
 // NotificationGotify describes the notification data model.
 type NotificationGotify struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Server                        types.String `tfsdk:"server"`
-	Name                          types.String `tfsdk:"name"`
-	AppToken                      types.String `tfsdk:"app_token"`
-	Priority                      types.Int64  `tfsdk:"priority"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	Server   types.String `tfsdk:"server"`
+	AppToken types.String `tfsdk:"app_token"`
+	NotificationBase
+	Priority                    types.Int64 `tfsdk:"priority"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationGotify) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		Server:                        n.Server,
-		AppToken:                      n.AppToken,
-		Priority:                      n.Priority,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationGotifyConfigContract),
-		Implementation:                types.StringValue(notificationGotifyImplementation),
+		NotificationBase:            n.NotificationBase,
+		Server:                      n.Server,
+		AppToken:                    n.AppToken,
+		Priority:                    n.Priority,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationGotifyConfigContract),
+		Implementation:              types.StringValue(notificationGotifyImplementation),
 	}
 }
 
 func (n *NotificationGotify) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.Server = notification.Server
 	n.AppToken = notification.AppToken
 	n.Priority = notification.Priority
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationGotifyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -125,6 +98,18 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
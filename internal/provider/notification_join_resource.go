@@ -42,70 +42,43 @@ type NotificationJoinResource struct {
 
 // NotificationJoin describes the notification data model.
 type NotificationJoin struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	DeviceNames                   types.String `tfsdk:"device_names"`
-	Name                          types.String `tfsdk:"name"`
-	APIKey                        types.String `tfsdk:"api_key"`
-	Priority                      types.Int64  `tfsdk:"priority"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	DeviceNames types.String `tfsdk:"device_names"`
+	APIKey      types.String `tfsdk:"api_key"`
+	NotificationBase
+	Priority                    types.Int64 `tfsdk:"priority"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationJoin) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		DeviceNames:                   n.DeviceNames,
-		APIKey:                        n.APIKey,
-		Priority:                      n.Priority,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationJoinConfigContract),
-		Implementation:                types.StringValue(notificationJoinImplementation),
+		NotificationBase:            n.NotificationBase,
+		DeviceNames:                 n.DeviceNames,
+		APIKey:                      n.APIKey,
+		Priority:                    n.Priority,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationJoinConfigContract),
+		Implementation:              types.StringValue(notificationJoinImplementation),
 	}
 }
 
 func (n *NotificationJoin) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.DeviceNames = notification.DeviceNames
 	n.APIKey = notification.APIKey
 	n.Priority = notification.Priority
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationJoinResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -125,6 +98,18 @@ func (r *NotificationJoinResource) Schema(_ context.Context, _ resource.SchemaRe
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
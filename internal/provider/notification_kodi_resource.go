@@ -40,94 +40,67 @@ type NotificationKodiResource struct {
 
 // NotificationKodi describes the notification data model.
 type NotificationKodi struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Host                          types.String `tfsdk:"host"`
-	Name                          types.String `tfsdk:"name"`
-	Username                      types.String `tfsdk:"username"`
-	Password                      types.String `tfsdk:"password"`
-	DisplayTime                   types.Int64  `tfsdk:"display_time"`
-	Port                          types.Int64  `tfsdk:"port"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	UseSSL                        types.Bool   `tfsdk:"use_ssl"`
-	Notify                        types.Bool   `tfsdk:"notify"`
-	UpdateLibrary                 types.Bool   `tfsdk:"update_library"`
-	CleanLibrary                  types.Bool   `tfsdk:"clean_library"`
-	AlwaysUpdate                  types.Bool   `tfsdk:"always_update"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	Host     types.String `tfsdk:"host"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	NotificationBase
+	DisplayTime                 types.Int64 `tfsdk:"display_time"`
+	Port                        types.Int64 `tfsdk:"port"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	UseSSL                      types.Bool  `tfsdk:"use_ssl"`
+	Notify                      types.Bool  `tfsdk:"notify"`
+	UpdateLibrary               types.Bool  `tfsdk:"update_library"`
+	CleanLibrary                types.Bool  `tfsdk:"clean_library"`
+	AlwaysUpdate                types.Bool  `tfsdk:"always_update"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
+	OnRename                    types.Bool  `tfsdk:"on_rename"`
 }
 
 func (n NotificationKodi) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		Port:                          n.Port,
-		Host:                          n.Host,
-		DisplayTime:                   n.DisplayTime,
-		Password:                      n.Password,
-		Username:                      n.Username,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		UseSSL:                        n.UseSSL,
-		Notify:                        n.Notify,
-		UpdateLibrary:                 n.UpdateLibrary,
-		AlwaysUpdate:                  n.AlwaysUpdate,
-		CleanLibrary:                  n.CleanLibrary,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnRename:                      n.OnRename,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationKodiConfigContract),
-		Implementation:                types.StringValue(notificationKodiImplementation),
+		NotificationBase:            n.NotificationBase,
+		Port:                        n.Port,
+		Host:                        n.Host,
+		DisplayTime:                 n.DisplayTime,
+		Password:                    n.Password,
+		Username:                    n.Username,
+		UseSSL:                      n.UseSSL,
+		Notify:                      n.Notify,
+		UpdateLibrary:               n.UpdateLibrary,
+		AlwaysUpdate:                n.AlwaysUpdate,
+		CleanLibrary:                n.CleanLibrary,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		OnRename:                    n.OnRename,
+		ConfigContract:              types.StringValue(notificationKodiConfigContract),
+		Implementation:              types.StringValue(notificationKodiImplementation),
 	}
 }
 
 func (n *NotificationKodi) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.Port = notification.Port
 	n.DisplayTime = notification.DisplayTime
 	n.Host = notification.Host
 	n.Password = notification.Password
 	n.Username = notification.Username
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.UseSSL = notification.UseSSL
 	n.Notify = notification.Notify
 	n.UpdateLibrary = notification.UpdateLibrary
 	n.AlwaysUpdate = notification.AlwaysUpdate
 	n.CleanLibrary = notification.CleanLibrary
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
 	n.OnRename = notification.OnRename
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationKodiResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -147,6 +120,18 @@ func (r *NotificationKodiResource) Schema(_ context.Context, _ resource.SchemaRe
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
@@ -40,76 +40,49 @@ type NotificationMailgunResource struct {
 
 // NotificationMailgun describes the notification data model.
 type NotificationMailgun struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Recipients                    types.Set    `tfsdk:"recipients"`
-	From                          types.String `tfsdk:"from"`
-	SenderDomain                  types.String `tfsdk:"sender_domain"`
-	Name                          types.String `tfsdk:"name"`
-	APIKey                        types.String `tfsdk:"api_key"`
-	ID                            types.Int64  `tfsdk:"id"`
-	UseEuEndpoint                 types.Bool   `tfsdk:"use_eu_endpoint"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	Recipients   types.Set    `tfsdk:"recipients"`
+	From         types.String `tfsdk:"from"`
+	SenderDomain types.String `tfsdk:"sender_domain"`
+	APIKey       types.String `tfsdk:"api_key"`
+	NotificationBase
+	UseEuEndpoint               types.Bool `tfsdk:"use_eu_endpoint"`
+	OnGrab                      types.Bool `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationMailgun) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		Recipients:                    n.Recipients,
-		SenderDomain:                  n.SenderDomain,
-		APIKey:                        n.APIKey,
-		UseEuEndpoint:                 n.UseEuEndpoint,
-		Name:                          n.Name,
-		From:                          n.From,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationMailgunConfigContract),
-		Implementation:                types.StringValue(notificationMailgunImplementation),
+		NotificationBase:            n.NotificationBase,
+		Recipients:                  n.Recipients,
+		SenderDomain:                n.SenderDomain,
+		APIKey:                      n.APIKey,
+		UseEuEndpoint:               n.UseEuEndpoint,
+		From:                        n.From,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationMailgunConfigContract),
+		Implementation:              types.StringValue(notificationMailgunImplementation),
 	}
 }
 
 func (n *NotificationMailgun) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.Recipients = notification.Recipients
 	n.SenderDomain = notification.SenderDomain
 	n.APIKey = notification.APIKey
 	n.UseEuEndpoint = notification.UseEuEndpoint
-	n.Name = notification.Name
 	n.From = notification.From
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationMailgunResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -129,6 +102,18 @@ func (r *NotificationMailgunResource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
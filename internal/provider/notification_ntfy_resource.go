@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -22,12 +24,15 @@ const (
 	notificationNtfyResourceName   = "notification_ntfy"
 	notificationNtfyImplementation = "Ntfy"
 	notificationNtfyConfigContract = "NtfySettings"
+	notificationNtfyPublicServer   = "https://ntfy.sh"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationNtfyResource{}
-	_ resource.ResourceWithImportState = &NotificationNtfyResource{}
+	_ resource.Resource                     = &NotificationNtfyResource{}
+	_ resource.ResourceWithImportState      = &NotificationNtfyResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationNtfyResource{}
+	_ resource.ConfigValidator              = NotificationNtfyAuthValidator{}
 )
 
 func NewNotificationNtfyResource() resource.Resource {
@@ -42,63 +47,45 @@ type NotificationNtfyResource struct {
 
 // NotificationNtfy describes the notification data model.
 type NotificationNtfy struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	FieldTags                     types.Set    `tfsdk:"field_tags"`
-	Topics                        types.Set    `tfsdk:"topics"`
-	Name                          types.String `tfsdk:"name"`
-	ClickURL                      types.String `tfsdk:"click_url"`
-	ServerURL                     types.String `tfsdk:"server_url"`
-	Username                      types.String `tfsdk:"username"`
-	Password                      types.String `tfsdk:"password"`
-	AccessToken                   types.String `tfsdk:"access_token"`
-	Priority                      types.Int64  `tfsdk:"priority"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	FieldTags   types.Set    `tfsdk:"field_tags"`
+	Topics      types.Set    `tfsdk:"topics"`
+	ClickURL    types.String `tfsdk:"click_url"`
+	ServerURL   types.String `tfsdk:"server_url"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	AccessToken types.String `tfsdk:"access_token"`
+	NotificationBase
+	Priority                    types.Int64 `tfsdk:"priority"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationNtfy) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		FieldTags:                     n.FieldTags,
-		Topics:                        n.Topics,
-		ClickURL:                      n.ClickURL,
-		ServerURL:                     n.ServerURL,
-		Username:                      n.Username,
-		Password:                      n.Password,
-		AccessToken:                   n.AccessToken,
-		Priority:                      n.Priority,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationNtfyConfigContract),
-		Implementation:                types.StringValue(notificationNtfyImplementation),
+		NotificationBase:            n.NotificationBase,
+		FieldTags:                   n.FieldTags,
+		Topics:                      n.Topics,
+		ClickURL:                    n.ClickURL,
+		ServerURL:                   n.ServerURL,
+		Username:                    n.Username,
+		Password:                    n.Password,
+		AccessToken:                 n.AccessToken,
+		Priority:                    n.Priority,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationNtfyConfigContract),
+		Implementation:              types.StringValue(notificationNtfyImplementation),
 	}
 }
 
 func (n *NotificationNtfy) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.FieldTags = notification.FieldTags
 	n.Topics = notification.Topics
 	n.ClickURL = notification.ClickURL
@@ -107,20 +94,11 @@ func (n *NotificationNtfy) fromNotification(notification *Notification) {
 	n.Password = notification.Password
 	n.AccessToken = notification.AccessToken
 	n.Priority = notification.Priority
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationNtfyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -140,6 +118,18 @@ func (r *NotificationNtfyResource) Schema(_ context.Context, _ resource.SchemaRe
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
@@ -218,7 +208,7 @@ func (r *NotificationNtfyResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"server_url": schema.StringAttribute{
-				MarkdownDescription: "Server URL.",
+				MarkdownDescription: "Server URL. Servers other than `https://ntfy.sh` require either `access_token` or both `username` and `password`.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -266,6 +256,12 @@ func (r *NotificationNtfyResource) Configure(ctx context.Context, req resource.C
 	}
 }
 
+func (r *NotificationNtfyResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NotificationNtfyAuthValidator{},
+	}
+}
+
 func (r *NotificationNtfyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationNtfy
@@ -377,3 +373,56 @@ func (n *NotificationNtfy) write(ctx context.Context, notification *sonarr.Notif
 func (n *NotificationNtfy) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.NotificationResource {
 	return n.toNotification().read(ctx, diags)
 }
+
+// NotificationNtfyAuthValidator checks a custom ntfy server is configured with exactly one authentication method.
+type NotificationNtfyAuthValidator struct{}
+
+func (v NotificationNtfyAuthValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v NotificationNtfyAuthValidator) MarkdownDescription(_ context.Context) string {
+	return "Custom server_url requires either access_token or both username and password."
+}
+
+func (v NotificationNtfyAuthValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var serverURL, username, password, token types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("server_url"), &serverURL)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("username"), &username)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("access_token"), &token)...)
+
+	if resp.Diagnostics.HasError() || serverURL.IsUnknown() || username.IsUnknown() || password.IsUnknown() || token.IsUnknown() {
+		return
+	}
+
+	// The public instance allows anonymous notifications
+	if serverURL.ValueString() == "" || strings.TrimSuffix(serverURL.ValueString(), "/") == notificationNtfyPublicServer {
+		return
+	}
+
+	basicAuth := username.ValueString() != "" || password.ValueString() != ""
+	tokenAuth := token.ValueString() != ""
+
+	switch {
+	case basicAuth && tokenAuth:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			helpers.ResourceError,
+			"Only one of access_token or username and password can be set",
+		)
+	case basicAuth && (username.ValueString() == "" || password.ValueString() == ""):
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			helpers.ResourceError,
+			"Both username and password must be set for basic authentication",
+		)
+	case !basicAuth && !tokenAuth:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("server_url"),
+			helpers.ResourceError,
+			fmt.Sprintf("Server '%s' requires either access_token or both username and password", serverURL.ValueString()),
+		)
+	}
+}
@@ -20,6 +20,24 @@ func TestAccNotificationNtfyResource(t *testing.T) {
 				Config:      testAccNotificationNtfyResourceConfig("resourceNtfyTest", "token123") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Custom server without authentication
+			{
+				Config:      testAccNotificationNtfyResourceAuthConfig(""),
+				ExpectError: regexp.MustCompile("requires either access_token or both username and password"),
+				PlanOnly:    true,
+			},
+			// Custom server with both authentication methods
+			{
+				Config:      testAccNotificationNtfyResourceAuthConfig(`access_token = "token"` + "\n\t\tusername = \"User\"\n\t\tpassword = \"Pass\""),
+				ExpectError: regexp.MustCompile("Only one of access_token or username and password can be set"),
+				PlanOnly:    true,
+			},
+			// Custom server with incomplete basic authentication
+			{
+				Config:      testAccNotificationNtfyResourceAuthConfig(`username = "User"`),
+				ExpectError: regexp.MustCompile("Both username and password must be set"),
+				PlanOnly:    true,
+			},
 			// Create and Read testing
 			{
 				Config: testAccNotificationNtfyResourceConfig("resourceNtfyTest", "token123"),
@@ -75,3 +93,13 @@ func testAccNotificationNtfyResourceConfig(name, token string) string {
 		field_tags = ["warning","skull"]
 	}`, name, token)
 }
+
+func testAccNotificationNtfyResourceAuthConfig(auth string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_notification_ntfy" "test" {
+		name = "resourceNtfyTest"
+		server_url = "https://ntfy.example.com"
+		topics = ["Topic1234"]
+		%s
+	}`, auth)
+}
@@ -40,64 +40,37 @@ type NotificationPlexResource struct {
 
 // NotificationPlex describes the notification data model.
 type NotificationPlex struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Host                          types.String `tfsdk:"host"`
-	AuthToken                     types.String `tfsdk:"auth_token"`
-	Name                          types.String `tfsdk:"name"`
-	ID                            types.Int64  `tfsdk:"id"`
-	Port                          types.Int64  `tfsdk:"port"`
-	UpdateLibrary                 types.Bool   `tfsdk:"update_library"`
-	UseSSL                        types.Bool   `tfsdk:"use_ssl"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	Host      types.String `tfsdk:"host"`
+	AuthToken types.String `tfsdk:"auth_token"`
+	NotificationBase
+	Port          types.Int64 `tfsdk:"port"`
+	UpdateLibrary types.Bool  `tfsdk:"update_library"`
+	UseSSL        types.Bool  `tfsdk:"use_ssl"`
+	OnRename      types.Bool  `tfsdk:"on_rename"`
 }
 
 func (n NotificationPlex) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		Host:                          n.Host,
-		Name:                          n.Name,
-		AuthToken:                     n.AuthToken,
-		ID:                            n.ID,
-		Port:                          n.Port,
-		UpdateLibrary:                 n.UpdateLibrary,
-		UseSSL:                        n.UseSSL,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnRename:                      n.OnRename,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationPlexConfigContract),
-		Implementation:                types.StringValue(notificationPlexImplementation),
+		NotificationBase: n.NotificationBase,
+		Host:             n.Host,
+		AuthToken:        n.AuthToken,
+		Port:             n.Port,
+		UpdateLibrary:    n.UpdateLibrary,
+		UseSSL:           n.UseSSL,
+		OnRename:         n.OnRename,
+		ConfigContract:   types.StringValue(notificationPlexConfigContract),
+		Implementation:   types.StringValue(notificationPlexImplementation),
 	}
 }
 
 func (n *NotificationPlex) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.Host = notification.Host
-	n.Name = notification.Name
 	n.AuthToken = notification.AuthToken
-	n.ID = notification.ID
 	n.UpdateLibrary = notification.UpdateLibrary
 	n.Port = notification.Port
 	n.UseSSL = notification.UseSSL
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
 	n.OnRename = notification.OnRename
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationPlexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -112,6 +85,18 @@ func (r *NotificationPlexResource) Schema(_ context.Context, _ resource.SchemaRe
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
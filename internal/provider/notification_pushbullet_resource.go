@@ -40,73 +40,46 @@ type NotificationPushbulletResource struct {
 
 // NotificationPushbullet describes the notification data model.
 type NotificationPushbullet struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	DeviceIDs                     types.Set    `tfsdk:"device_ids"`
-	ChannelTags                   types.Set    `tfsdk:"channel_tags"`
-	SenderID                      types.String `tfsdk:"sender_id"`
-	Name                          types.String `tfsdk:"name"`
-	APIKey                        types.String `tfsdk:"api_key"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	DeviceIDs   types.Set    `tfsdk:"device_ids"`
+	ChannelTags types.Set    `tfsdk:"channel_tags"`
+	SenderID    types.String `tfsdk:"sender_id"`
+	APIKey      types.String `tfsdk:"api_key"`
+	NotificationBase
+	OnGrab                      types.Bool `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationPushbullet) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		DeviceIDs:                     n.DeviceIDs,
-		ChannelTags:                   n.ChannelTags,
-		SenderID:                      n.SenderID,
-		APIKey:                        n.APIKey,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationPushbulletConfigContract),
-		Implementation:                types.StringValue(notificationPushbulletImplementation),
+		NotificationBase:            n.NotificationBase,
+		DeviceIDs:                   n.DeviceIDs,
+		ChannelTags:                 n.ChannelTags,
+		SenderID:                    n.SenderID,
+		APIKey:                      n.APIKey,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationPushbulletConfigContract),
+		Implementation:              types.StringValue(notificationPushbulletImplementation),
 	}
 }
 
 func (n *NotificationPushbullet) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.DeviceIDs = notification.DeviceIDs
 	n.ChannelTags = notification.ChannelTags
 	n.SenderID = notification.SenderID
 	n.APIKey = notification.APIKey
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationPushbulletResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -126,6 +99,18 @@ func (r *NotificationPushbulletResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
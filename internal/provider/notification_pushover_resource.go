@@ -42,61 +42,43 @@ type NotificationPushoverResource struct {
 
 // NotificationPushover describes the notification data model.
 type NotificationPushover struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Devices                       types.Set    `tfsdk:"devices"`
-	Sound                         types.String `tfsdk:"sound"`
-	Name                          types.String `tfsdk:"name"`
-	APIKey                        types.String `tfsdk:"api_key"`
-	UserKey                       types.String `tfsdk:"user_key"`
-	Priority                      types.Int64  `tfsdk:"priority"`
-	ID                            types.Int64  `tfsdk:"id"`
-	Retry                         types.Int64  `tfsdk:"retry"`
-	Expire                        types.Int64  `tfsdk:"expire"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	Devices types.Set    `tfsdk:"devices"`
+	Sound   types.String `tfsdk:"sound"`
+	APIKey  types.String `tfsdk:"api_key"`
+	UserKey types.String `tfsdk:"user_key"`
+	NotificationBase
+	Priority                    types.Int64 `tfsdk:"priority"`
+	Retry                       types.Int64 `tfsdk:"retry"`
+	Expire                      types.Int64 `tfsdk:"expire"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationPushover) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		Devices:                       n.Devices,
-		Sound:                         n.Sound,
-		APIKey:                        n.APIKey,
-		UserKey:                       n.UserKey,
-		Retry:                         n.Retry,
-		Expire:                        n.Expire,
-		Priority:                      n.Priority,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationPushoverConfigContract),
-		Implementation:                types.StringValue(notificationPushoverImplementation),
+		NotificationBase:            n.NotificationBase,
+		Devices:                     n.Devices,
+		Sound:                       n.Sound,
+		APIKey:                      n.APIKey,
+		UserKey:                     n.UserKey,
+		Retry:                       n.Retry,
+		Expire:                      n.Expire,
+		Priority:                    n.Priority,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationPushoverConfigContract),
+		Implementation:              types.StringValue(notificationPushoverImplementation),
 	}
 }
 
 func (n *NotificationPushover) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.Devices = notification.Devices
 	n.Sound = notification.Sound
 	n.APIKey = notification.APIKey
@@ -104,20 +86,11 @@ func (n *NotificationPushover) fromNotification(notification *Notification) {
 	n.Retry = notification.Retry
 	n.Expire = notification.Expire
 	n.Priority = notification.Priority
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationPushoverResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -137,6 +110,18 @@ func (r *NotificationPushoverResource) Schema(_ context.Context, _ resource.Sche
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
@@ -30,7 +30,7 @@ var (
 var notificationFields = helpers.Fields{
 	Bools:                  []string{"alwaysUpdate", "cleanLibrary", "directMessage", "notify", "sendSilently", "updateLibrary", "useEuEndpoint", "useSsl"},
 	Strings:                []string{"accessToken", "accessTokenSecret", "apiKey", "appToken", "arguments", "author", "authToken", "authUser", "avatar", "botToken", "channel", "chatId", "consumerKey", "consumerSecret", "deviceNames", "expires", "from", "host", "icon", "mention", "password", "path", "refreshToken", "senderDomain", "senderId", "server", "signIn", "sound", "token", "url", "userKey", "username", "userName", "webHookUrl", "clickUrl", "serverUrl", "authUsername", "authPassword", "statelessUrls", "configurationKey", "senderNumber", "receiverId", "key", "event"},
-	Ints:                   []string{"method", "port", "priority", "retry", "expire", "displayTime", "notificationType", "useEncryption"},
+	Ints:                   []string{"method", "port", "priority", "retry", "expire", "displayTime", "notificationType", "useEncryption", "topicId"},
 	StringSlices:           []string{"channelTags", "deviceIds", "devices", "recipients", "to", "cc", "bcc", "topics", "fieldTags"},
 	StringSlicesExceptions: []string{"tags"},
 	IntSlices:              []string{"grabFields", "importFields"},
@@ -46,96 +46,103 @@ type NotificationResource struct {
 	auth   context.Context
 }
 
-// Notification describes the notification data model.
-type Notification struct {
+// NotificationBase contains the fields shared by all the notification implementations.
+type NotificationBase struct {
 	Tags                          types.Set    `tfsdk:"tags"`
-	FieldTags                     types.Set    `tfsdk:"field_tags"`
-	Topics                        types.Set    `tfsdk:"topics"`
-	Recipients                    types.Set    `tfsdk:"recipients"`
-	Devices                       types.Set    `tfsdk:"devices"`
-	DeviceIDs                     types.Set    `tfsdk:"device_ids"`
-	ChannelTags                   types.Set    `tfsdk:"channel_tags"`
-	ImportFields                  types.Set    `tfsdk:"import_fields"`
-	GrabFields                    types.Set    `tfsdk:"grab_fields"`
-	To                            types.Set    `tfsdk:"to"`
-	Cc                            types.Set    `tfsdk:"cc"`
-	Bcc                           types.Set    `tfsdk:"bcc"`
-	Path                          types.String `tfsdk:"path"`
-	RefreshToken                  types.String `tfsdk:"refresh_token"`
-	WebHookURL                    types.String `tfsdk:"web_hook_url"`
-	Username                      types.String `tfsdk:"username"`
-	UserKey                       types.String `tfsdk:"user_key"`
-	Mention                       types.String `tfsdk:"mention"`
-	ClickURL                      types.String `tfsdk:"click_url"`
-	ServerURL                     types.String `tfsdk:"server_url"`
-	StatelessURLs                 types.String `tfsdk:"stateless_urls"`
 	Name                          types.String `tfsdk:"name"`
-	Avatar                        types.String `tfsdk:"avatar"`
-	ConfigContract                types.String `tfsdk:"config_contract"`
-	URL                           types.String `tfsdk:"url"`
-	Token                         types.String `tfsdk:"token"`
-	Sound                         types.String `tfsdk:"sound"`
-	SignIn                        types.String `tfsdk:"sign_in"`
-	Server                        types.String `tfsdk:"server"`
-	SenderID                      types.String `tfsdk:"sender_id"`
-	SenderNumber                  types.String `tfsdk:"sender_number"`
-	ReceiverID                    types.String `tfsdk:"receiver_id"`
-	BotToken                      types.String `tfsdk:"bot_token"`
-	SenderDomain                  types.String `tfsdk:"sender_domain"`
-	Icon                          types.String `tfsdk:"icon"`
-	Host                          types.String `tfsdk:"host"`
-	From                          types.String `tfsdk:"from"`
-	Expires                       types.String `tfsdk:"expires"`
-	AccessToken                   types.String `tfsdk:"access_token"`
-	AccessTokenSecret             types.String `tfsdk:"access_token_secret"`
-	APIKey                        types.String `tfsdk:"api_key"`
-	AppToken                      types.String `tfsdk:"app_token"`
-	Arguments                     types.String `tfsdk:"arguments"`
-	Author                        types.String `tfsdk:"author"`
-	AuthToken                     types.String `tfsdk:"auth_token"`
-	AuthUser                      types.String `tfsdk:"auth_user"`
-	Implementation                types.String `tfsdk:"implementation"`
-	Password                      types.String `tfsdk:"password"`
-	Channel                       types.String `tfsdk:"channel"`
-	ChatID                        types.String `tfsdk:"chat_id"`
-	ConsumerKey                   types.String `tfsdk:"consumer_key"`
-	ConsumerSecret                types.String `tfsdk:"consumer_secret"`
-	DeviceNames                   types.String `tfsdk:"device_names"`
-	AuthUsername                  types.String `tfsdk:"auth_username"`
-	AuthPassword                  types.String `tfsdk:"auth_password"`
-	ConfigurationKey              types.String `tfsdk:"configuration_key"`
-	Key                           types.String `tfsdk:"key"`
-	Event                         types.String `tfsdk:"event"`
-	NotificationType              types.Int64  `tfsdk:"notification_type"`
-	Expire                        types.Int64  `tfsdk:"expire"`
-	DisplayTime                   types.Int64  `tfsdk:"display_time"`
-	Priority                      types.Int64  `tfsdk:"priority"`
-	Port                          types.Int64  `tfsdk:"port"`
-	Method                        types.Int64  `tfsdk:"method"`
-	Retry                         types.Int64  `tfsdk:"retry"`
-	UseEncryption                 types.Int64  `tfsdk:"use_encryption"`
 	ID                            types.Int64  `tfsdk:"id"`
-	UpdateLibrary                 types.Bool   `tfsdk:"update_library"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	UseEuEndpoint                 types.Bool   `tfsdk:"use_eu_endpoint"`
-	Notify                        types.Bool   `tfsdk:"notify"`
-	UseSSL                        types.Bool   `tfsdk:"use_ssl"`
+	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
 	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	SendSilently                  types.Bool   `tfsdk:"send_silently"`
-	DirectMessage                 types.Bool   `tfsdk:"direct_message"`
-	CleanLibrary                  types.Bool   `tfsdk:"clean_library"`
-	AlwaysUpdate                  types.Bool   `tfsdk:"always_update"`
 	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
 	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
 	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
 	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
 	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	OnImport                      types.Bool   `tfsdk:"on_import"`
+}
+
+// Notification describes the notification data model.
+type Notification struct {
+	FieldTags         types.Set    `tfsdk:"field_tags"`
+	Topics            types.Set    `tfsdk:"topics"`
+	Recipients        types.Set    `tfsdk:"recipients"`
+	Devices           types.Set    `tfsdk:"devices"`
+	DeviceIDs         types.Set    `tfsdk:"device_ids"`
+	ChannelTags       types.Set    `tfsdk:"channel_tags"`
+	ImportFields      types.Set    `tfsdk:"import_fields"`
+	GrabFields        types.Set    `tfsdk:"grab_fields"`
+	To                types.Set    `tfsdk:"to"`
+	Cc                types.Set    `tfsdk:"cc"`
+	Bcc               types.Set    `tfsdk:"bcc"`
+	Path              types.String `tfsdk:"path"`
+	RefreshToken      types.String `tfsdk:"refresh_token"`
+	WebHookURL        types.String `tfsdk:"web_hook_url"`
+	Username          types.String `tfsdk:"username"`
+	UserKey           types.String `tfsdk:"user_key"`
+	Mention           types.String `tfsdk:"mention"`
+	ClickURL          types.String `tfsdk:"click_url"`
+	ServerURL         types.String `tfsdk:"server_url"`
+	StatelessURLs     types.String `tfsdk:"stateless_urls"`
+	Avatar            types.String `tfsdk:"avatar"`
+	ConfigContract    types.String `tfsdk:"config_contract"`
+	URL               types.String `tfsdk:"url"`
+	Token             types.String `tfsdk:"token"`
+	Sound             types.String `tfsdk:"sound"`
+	SignIn            types.String `tfsdk:"sign_in"`
+	Server            types.String `tfsdk:"server"`
+	SenderID          types.String `tfsdk:"sender_id"`
+	SenderNumber      types.String `tfsdk:"sender_number"`
+	ReceiverID        types.String `tfsdk:"receiver_id"`
+	BotToken          types.String `tfsdk:"bot_token"`
+	SenderDomain      types.String `tfsdk:"sender_domain"`
+	Icon              types.String `tfsdk:"icon"`
+	Host              types.String `tfsdk:"host"`
+	From              types.String `tfsdk:"from"`
+	Expires           types.String `tfsdk:"expires"`
+	AccessToken       types.String `tfsdk:"access_token"`
+	AccessTokenSecret types.String `tfsdk:"access_token_secret"`
+	APIKey            types.String `tfsdk:"api_key"`
+	AppToken          types.String `tfsdk:"app_token"`
+	Arguments         types.String `tfsdk:"arguments"`
+	Author            types.String `tfsdk:"author"`
+	AuthToken         types.String `tfsdk:"auth_token"`
+	AuthUser          types.String `tfsdk:"auth_user"`
+	Implementation    types.String `tfsdk:"implementation"`
+	Password          types.String `tfsdk:"password"`
+	Channel           types.String `tfsdk:"channel"`
+	ChatID            types.String `tfsdk:"chat_id"`
+	ConsumerKey       types.String `tfsdk:"consumer_key"`
+	ConsumerSecret    types.String `tfsdk:"consumer_secret"`
+	DeviceNames       types.String `tfsdk:"device_names"`
+	AuthUsername      types.String `tfsdk:"auth_username"`
+	AuthPassword      types.String `tfsdk:"auth_password"`
+	ConfigurationKey  types.String `tfsdk:"configuration_key"`
+	Key               types.String `tfsdk:"key"`
+	Event             types.String `tfsdk:"event"`
+	NotificationBase
+	NotificationType            types.Int64 `tfsdk:"notification_type"`
+	Expire                      types.Int64 `tfsdk:"expire"`
+	DisplayTime                 types.Int64 `tfsdk:"display_time"`
+	Priority                    types.Int64 `tfsdk:"priority"`
+	Port                        types.Int64 `tfsdk:"port"`
+	Method                      types.Int64 `tfsdk:"method"`
+	Retry                       types.Int64 `tfsdk:"retry"`
+	UseEncryption               types.Int64 `tfsdk:"use_encryption"`
+	TopicID                     types.Int64 `tfsdk:"topic_id"`
+	UpdateLibrary               types.Bool  `tfsdk:"update_library"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	UseEuEndpoint               types.Bool  `tfsdk:"use_eu_endpoint"`
+	Notify                      types.Bool  `tfsdk:"notify"`
+	UseSSL                      types.Bool  `tfsdk:"use_ssl"`
+	SendSilently                types.Bool  `tfsdk:"send_silently"`
+	DirectMessage               types.Bool  `tfsdk:"direct_message"`
+	CleanLibrary                types.Bool  `tfsdk:"clean_library"`
+	AlwaysUpdate                types.Bool  `tfsdk:"always_update"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
+	OnRename                    types.Bool  `tfsdk:"on_rename"`
 }
 
 func (n Notification) getType() attr.Type {
@@ -207,6 +214,7 @@ func (n Notification) getType() attr.Type {
 			"method":                             types.Int64Type,
 			"retry":                              types.Int64Type,
 			"use_encryption":                     types.Int64Type,
+			"topic_id":                           types.Int64Type,
 			"id":                                 types.Int64Type,
 			"update_library":                     types.BoolType,
 			"on_grab":                            types.BoolType,
@@ -229,6 +237,7 @@ func (n Notification) getType() attr.Type {
 			"on_rename":                          types.BoolType,
 			"on_upgrade":                         types.BoolType,
 			"on_download":                        types.BoolType,
+			"on_import":                          types.BoolType,
 		})
 }
 
@@ -249,6 +258,18 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
@@ -525,6 +546,11 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:            true,
 				Computed:            true,
 			},
+			"topic_id": schema.Int64Attribute{
+				MarkdownDescription: "Topic ID, to send messages to a forum topic of the chat.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"consumer_key": schema.StringAttribute{
 				MarkdownDescription: "Consumer key.",
 				Optional:            true,
@@ -840,26 +866,14 @@ func (r *NotificationResource) ImportState(ctx context.Context, req resource.Imp
 }
 
 func (n *Notification) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
-	var localDiag diag.Diagnostics
-
-	n.Tags, localDiag = types.SetValueFrom(ctx, types.Int64Type, notification.Tags)
-	diags.Append(localDiag...)
+	n.writeNotificationBase(ctx, notification, diags)
 
 	n.OnGrab = types.BoolValue(notification.GetOnGrab())
-	n.OnDownload = types.BoolValue(notification.GetOnDownload())
-	n.OnUpgrade = types.BoolValue(notification.GetOnUpgrade())
 	n.OnRename = types.BoolValue(notification.GetOnRename())
-	n.OnSeriesAdd = types.BoolValue(notification.GetOnSeriesAdd())
-	n.OnSeriesDelete = types.BoolValue(notification.GetOnSeriesDelete())
-	n.OnEpisodeFileDelete = types.BoolValue(notification.GetOnEpisodeFileDelete())
-	n.OnEpisodeFileDeleteForUpgrade = types.BoolValue(notification.GetOnEpisodeFileDeleteForUpgrade())
 	n.OnHealthIssue = types.BoolValue(notification.GetOnHealthIssue())
 	n.OnHealthRestored = types.BoolValue(notification.GetOnHealthRestored())
 	n.OnApplicationUpdate = types.BoolValue(notification.GetOnApplicationUpdate())
 	n.OnManualInteractionRequired = types.BoolValue(notification.GetOnManualInteractionRequired())
-	n.IncludeHealthWarnings = types.BoolValue(notification.GetIncludeHealthWarnings())
-	n.ID = types.Int64Value(int64(notification.GetId()))
-	n.Name = types.StringValue(notification.GetName())
 	n.Implementation = types.StringValue(notification.GetImplementation())
 	n.ConfigContract = types.StringValue(notification.GetConfigContract())
 	n.ImportFields = types.SetValueMust(types.Int64Type, nil)
@@ -878,29 +892,58 @@ func (n *Notification) write(ctx context.Context, notification *sonarr.Notificat
 
 func (n *Notification) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.NotificationResource {
 	notification := sonarr.NewNotificationResource()
+	n.readNotificationBase(ctx, notification, diags)
 	notification.SetOnGrab(n.OnGrab.ValueBool())
-	notification.SetOnDownload(n.OnDownload.ValueBool())
-	notification.SetOnUpgrade(n.OnUpgrade.ValueBool())
 	notification.SetOnRename(n.OnRename.ValueBool())
-	notification.SetOnSeriesAdd(n.OnSeriesAdd.ValueBool())
-	notification.SetOnSeriesDelete(n.OnSeriesDelete.ValueBool())
-	notification.SetOnEpisodeFileDelete(n.OnEpisodeFileDelete.ValueBool())
-	notification.SetOnEpisodeFileDeleteForUpgrade(n.OnEpisodeFileDeleteForUpgrade.ValueBool())
 	notification.SetOnHealthIssue(n.OnHealthIssue.ValueBool())
 	notification.SetOnHealthRestored(n.OnHealthRestored.ValueBool())
 	notification.SetOnApplicationUpdate(n.OnApplicationUpdate.ValueBool())
 	notification.SetOnManualInteractionRequired(n.OnManualInteractionRequired.ValueBool())
-	notification.SetIncludeHealthWarnings(n.IncludeHealthWarnings.ValueBool())
-	notification.SetId(int32(n.ID.ValueInt64()))
-	notification.SetName(n.Name.ValueString())
 	notification.SetImplementation(n.Implementation.ValueString())
 	notification.SetConfigContract(n.ConfigContract.ValueString())
-	diags.Append(n.Tags.ElementsAs(ctx, &notification.Tags, true)...)
 	notification.SetFields(helpers.ReadFields(ctx, n, notificationFields))
 
 	return notification
 }
 
+// writeNotificationBase writes the fields shared by all the notification implementations.
+func (b *NotificationBase) writeNotificationBase(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	b.Tags, localDiag = types.SetValueFrom(ctx, types.Int64Type, notification.Tags)
+	diags.Append(localDiag...)
+
+	b.OnDownload = types.BoolValue(notification.GetOnDownload())
+	b.OnImport = types.BoolValue(notification.GetOnDownload())
+	b.OnUpgrade = types.BoolValue(notification.GetOnUpgrade())
+	b.OnSeriesAdd = types.BoolValue(notification.GetOnSeriesAdd())
+	b.OnSeriesDelete = types.BoolValue(notification.GetOnSeriesDelete())
+	b.OnEpisodeFileDelete = types.BoolValue(notification.GetOnEpisodeFileDelete())
+	b.OnEpisodeFileDeleteForUpgrade = types.BoolValue(notification.GetOnEpisodeFileDeleteForUpgrade())
+	b.IncludeHealthWarnings = types.BoolValue(notification.GetIncludeHealthWarnings())
+	b.ID = types.Int64Value(int64(notification.GetId()))
+	b.Name = types.StringValue(notification.GetName())
+}
+
+// readNotificationBase reads the fields shared by all the notification implementations.
+func (b *NotificationBase) readNotificationBase(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
+	// on_import is the deprecated name of on_download
+	if b.OnDownload.IsNull() || b.OnDownload.IsUnknown() {
+		notification.SetOnDownload(b.OnImport.ValueBool())
+	} else {
+		notification.SetOnDownload(b.OnDownload.ValueBool())
+	}
+	notification.SetOnUpgrade(b.OnUpgrade.ValueBool())
+	notification.SetOnSeriesAdd(b.OnSeriesAdd.ValueBool())
+	notification.SetOnSeriesDelete(b.OnSeriesDelete.ValueBool())
+	notification.SetOnEpisodeFileDelete(b.OnEpisodeFileDelete.ValueBool())
+	notification.SetOnEpisodeFileDeleteForUpgrade(b.OnEpisodeFileDeleteForUpgrade.ValueBool())
+	notification.SetIncludeHealthWarnings(b.IncludeHealthWarnings.ValueBool())
+	notification.SetId(int32(b.ID.ValueInt64()))
+	notification.SetName(b.Name.ValueString())
+	diags.Append(b.Tags.ElementsAs(ctx, &notification.Tags, true)...)
+}
+
 // writeSensitive copy sensitive data from another resource.
 func (n *Notification) writeSensitive(notification *Notification) {
 	if !notification.Token.IsUnknown() {
@@ -40,61 +40,43 @@ type NotificationSignalResource struct {
 
 // NotificationSignal describes the notification data model.
 type NotificationSignal struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	AuthPassword                  types.String `tfsdk:"auth_password"`
-	AuthUsername                  types.String `tfsdk:"auth_username"`
-	Host                          types.String `tfsdk:"host"`
-	SenderNumber                  types.String `tfsdk:"sender_number"`
-	ReceiverID                    types.String `tfsdk:"receiver_id"`
-	Name                          types.String `tfsdk:"name"`
-	Port                          types.Int64  `tfsdk:"port"`
-	ID                            types.Int64  `tfsdk:"id"`
-	UseSSL                        types.Bool   `tfsdk:"use_ssl"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	AuthPassword types.String `tfsdk:"auth_password"`
+	AuthUsername types.String `tfsdk:"auth_username"`
+	Host         types.String `tfsdk:"host"`
+	SenderNumber types.String `tfsdk:"sender_number"`
+	ReceiverID   types.String `tfsdk:"receiver_id"`
+	NotificationBase
+	Port                        types.Int64 `tfsdk:"port"`
+	UseSSL                      types.Bool  `tfsdk:"use_ssl"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationSignal) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		AuthPassword:                  n.AuthPassword,
-		Name:                          n.Name,
-		AuthUsername:                  n.AuthUsername,
-		Host:                          n.Host,
-		SenderNumber:                  n.SenderNumber,
-		ReceiverID:                    n.ReceiverID,
-		Port:                          n.Port,
-		UseSSL:                        n.UseSSL,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationSignalConfigContract),
-		Implementation:                types.StringValue(notificationSignalImplementation),
+		NotificationBase:            n.NotificationBase,
+		AuthPassword:                n.AuthPassword,
+		AuthUsername:                n.AuthUsername,
+		Host:                        n.Host,
+		SenderNumber:                n.SenderNumber,
+		ReceiverID:                  n.ReceiverID,
+		Port:                        n.Port,
+		UseSSL:                      n.UseSSL,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationSignalConfigContract),
+		Implementation:              types.StringValue(notificationSignalImplementation),
 	}
 }
 
 func (n *NotificationSignal) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.AuthPassword = notification.AuthPassword
 	n.AuthUsername = notification.AuthUsername
 	n.Host = notification.Host
@@ -102,20 +84,11 @@ func (n *NotificationSignal) fromNotification(notification *Notification) {
 	n.ReceiverID = notification.ReceiverID
 	n.Port = notification.Port
 	n.UseSSL = notification.UseSSL
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationSignalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -135,6 +108,18 @@ func (r *NotificationSignalResource) Schema(_ context.Context, _ resource.Schema
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
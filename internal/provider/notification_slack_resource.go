@@ -40,76 +40,49 @@ type NotificationSlackResource struct {
 
 // NotificationSlack describes the notification data model.
 type NotificationSlack struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	WebHookURL                    types.String `tfsdk:"web_hook_url"`
-	Name                          types.String `tfsdk:"name"`
-	Username                      types.String `tfsdk:"username"`
-	Icon                          types.String `tfsdk:"icon"`
-	Channel                       types.String `tfsdk:"channel"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	WebHookURL types.String `tfsdk:"web_hook_url"`
+	Username   types.String `tfsdk:"username"`
+	Icon       types.String `tfsdk:"icon"`
+	Channel    types.String `tfsdk:"channel"`
+	NotificationBase
+	OnGrab                      types.Bool `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool `tfsdk:"on_manual_interaction_required"`
+	OnRename                    types.Bool `tfsdk:"on_rename"`
 }
 
 func (n NotificationSlack) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		WebHookURL:                    n.WebHookURL,
-		Icon:                          n.Icon,
-		Username:                      n.Username,
-		Channel:                       n.Channel,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnRename:                      n.OnRename,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationSlackConfigContract),
-		Implementation:                types.StringValue(notificationSlackImplementation),
+		NotificationBase:            n.NotificationBase,
+		WebHookURL:                  n.WebHookURL,
+		Icon:                        n.Icon,
+		Username:                    n.Username,
+		Channel:                     n.Channel,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		OnRename:                    n.OnRename,
+		ConfigContract:              types.StringValue(notificationSlackConfigContract),
+		Implementation:              types.StringValue(notificationSlackImplementation),
 	}
 }
 
 func (n *NotificationSlack) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.WebHookURL = notification.WebHookURL
 	n.Icon = notification.Icon
 	n.Username = notification.Username
 	n.Channel = notification.Channel
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
 	n.OnRename = notification.OnRename
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationSlackResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -129,6 +102,18 @@ func (r *NotificationSlackResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
@@ -212,12 +197,12 @@ func (r *NotificationSlackResource) Schema(_ context.Context, _ resource.SchemaR
 				Required:            true,
 			},
 			"icon": schema.StringAttribute{
-				MarkdownDescription: "Icon.",
+				MarkdownDescription: "Icon, an emoji such as `:tv:` or an image URL.",
 				Optional:            true,
 				Computed:            true,
 			},
 			"channel": schema.StringAttribute{
-				MarkdownDescription: "Channel.",
+				MarkdownDescription: "Channel, overrides the default channel of the web hook.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/terraform-provider-sonarr/internal/testutil"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -25,7 +26,8 @@ func TestAccNotificationSlackResource(t *testing.T) {
 				Config: testAccNotificationSlackResourceConfig("resourceSlackTest", "test"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_notification_slack.test", "channel", "test"),
-					resource.TestCheckResourceAttrSet("sonarr_notification_slack.test", "id"),
+					resource.TestCheckResourceAttr("sonarr_notification_slack.test", "icon", ":tv:"),
+					testutil.CheckNotificationExists("sonarr_notification_slack.test"),
 				),
 			},
 			// Unauthorized Read
@@ -52,23 +54,8 @@ func TestAccNotificationSlackResource(t *testing.T) {
 }
 
 func testAccNotificationSlackResourceConfig(name, channel string) string {
-	return fmt.Sprintf(`
-	resource "sonarr_notification_slack" "test" {
-		on_grab                            = false
-		on_download                        = false
-		on_upgrade                         = false
-		on_rename                          = false
-		on_series_delete                   = false
-		on_episode_file_delete             = false
-		on_episode_file_delete_for_upgrade = false
-		on_health_issue                    = false
-		on_application_update              = false
-	  
-		include_health_warnings = false
-		name                    = "%s"
-	  
-		web_hook_url = "http://my.slack.com/test"
-		username = "user"
-		channel = "%s"
-	}`, name, channel)
+	return testutil.NotificationTestConfig(name, "Slack", map[string]string{
+		"channel": fmt.Sprintf("%q", channel),
+		"icon":    `":tv:"`,
+	})
 }
@@ -40,52 +40,25 @@ type NotificationSynologyResource struct {
 
 // NotificationSynology describes the notification data model.
 type NotificationSynology struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Name                          types.String `tfsdk:"name"`
-	ID                            types.Int64  `tfsdk:"id"`
-	UpdateLibrary                 types.Bool   `tfsdk:"update_library"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	NotificationBase
+	UpdateLibrary types.Bool `tfsdk:"update_library"`
+	OnRename      types.Bool `tfsdk:"on_rename"`
 }
 
 func (n NotificationSynology) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		UpdateLibrary:                 n.UpdateLibrary,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnRename:                      n.OnRename,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationSynologyConfigContract),
-		Implementation:                types.StringValue(notificationSynologyImplementation),
+		NotificationBase: n.NotificationBase,
+		UpdateLibrary:    n.UpdateLibrary,
+		OnRename:         n.OnRename,
+		ConfigContract:   types.StringValue(notificationSynologyConfigContract),
+		Implementation:   types.StringValue(notificationSynologyImplementation),
 	}
 }
 
 func (n *NotificationSynology) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
-	n.Name = notification.Name
-	n.ID = notification.ID
+	n.NotificationBase = notification.NotificationBase
 	n.UpdateLibrary = notification.UpdateLibrary
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
 	n.OnRename = notification.OnRename
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationSynologyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -100,6 +73,18 @@ func (r *NotificationSynologyResource) Schema(_ context.Context, _ resource.Sche
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
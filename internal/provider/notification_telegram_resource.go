@@ -40,70 +40,46 @@ type NotificationTelegramResource struct {
 
 // NotificationTelegram describes the notification data model.
 type NotificationTelegram struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	ChatID                        types.String `tfsdk:"chat_id"`
-	Name                          types.String `tfsdk:"name"`
-	BotToken                      types.String `tfsdk:"bot_token"`
-	ID                            types.Int64  `tfsdk:"id"`
-	SendSilently                  types.Bool   `tfsdk:"send_silently"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	ChatID   types.String `tfsdk:"chat_id"`
+	BotToken types.String `tfsdk:"bot_token"`
+	NotificationBase
+	TopicID                     types.Int64 `tfsdk:"topic_id"`
+	SendSilently                types.Bool  `tfsdk:"send_silently"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationTelegram) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		ChatID:                        n.ChatID,
-		BotToken:                      n.BotToken,
-		SendSilently:                  n.SendSilently,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationTelegramConfigContract),
-		Implementation:                types.StringValue(notificationTelegramImplementation),
+		NotificationBase:            n.NotificationBase,
+		ChatID:                      n.ChatID,
+		BotToken:                    n.BotToken,
+		TopicID:                     n.TopicID,
+		SendSilently:                n.SendSilently,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationTelegramConfigContract),
+		Implementation:              types.StringValue(notificationTelegramImplementation),
 	}
 }
 
 func (n *NotificationTelegram) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.ChatID = notification.ChatID
 	n.BotToken = notification.BotToken
+	n.TopicID = notification.TopicID
 	n.SendSilently = notification.SendSilently
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationTelegramResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -123,6 +99,18 @@ func (r *NotificationTelegramResource) Schema(_ context.Context, _ resource.Sche
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
@@ -201,6 +189,10 @@ func (r *NotificationTelegramResource) Schema(_ context.Context, _ resource.Sche
 				MarkdownDescription: "Chat ID.",
 				Required:            true,
 			},
+			"topic_id": schema.Int64Attribute{
+				MarkdownDescription: "Topic ID, to send messages to a forum topic of the chat.",
+				Optional:            true,
+			},
 			"bot_token": schema.StringAttribute{
 				MarkdownDescription: "Bot token.",
 				Required:            true,
@@ -17,27 +17,29 @@ func TestAccNotificationTelegramResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Unauthorized Create
 			{
-				Config:      testAccNotificationTelegramResourceConfig("resourceTelegramTest", "chat01") + testUnauthorizedProvider,
+				Config:      testAccNotificationTelegramResourceConfig("resourceTelegramTest", "chat01", "") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Create and Read testing
 			{
-				Config: testAccNotificationTelegramResourceConfig("resourceTelegramTest", "chat01"),
+				Config: testAccNotificationTelegramResourceConfig("resourceTelegramTest", "chat01", ""),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_notification_telegram.test", "chat_id", "chat01"),
+					resource.TestCheckNoResourceAttr("sonarr_notification_telegram.test", "topic_id"),
 					resource.TestCheckResourceAttrSet("sonarr_notification_telegram.test", "id"),
 				),
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccNotificationTelegramResourceConfig("resourceTelegramTest", "chat01") + testUnauthorizedProvider,
+				Config:      testAccNotificationTelegramResourceConfig("resourceTelegramTest", "chat01", "") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccNotificationTelegramResourceConfig("resourceTelegramTest", "chat02"),
+				Config: testAccNotificationTelegramResourceConfig("resourceTelegramTest", "chat02", "topic_id = 12"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_notification_telegram.test", "chat_id", "chat02"),
+					resource.TestCheckResourceAttr("sonarr_notification_telegram.test", "topic_id", "12"),
 				),
 			},
 			// ImportState testing
@@ -52,7 +54,7 @@ func TestAccNotificationTelegramResource(t *testing.T) {
 	})
 }
 
-func testAccNotificationTelegramResourceConfig(name, chat string) string {
+func testAccNotificationTelegramResourceConfig(name, chat, topic string) string {
 	return fmt.Sprintf(`
 	resource "sonarr_notification_telegram" "test" {
 		on_grab                            = false
@@ -69,5 +71,6 @@ func testAccNotificationTelegramResourceConfig(name, chat string) string {
 	  
 		chat_id = "%s"
 		bot_token = "Token"
-	}`, name, chat)
+		%s
+	}`, name, chat, topic)
 }
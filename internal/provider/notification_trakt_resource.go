@@ -40,58 +40,31 @@ type NotificationTraktResource struct {
 
 // NotificationTrakt describes the notification data model.
 type NotificationTrakt struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	AuthUser                      types.String `tfsdk:"auth_user"`
-	AccessToken                   types.String `tfsdk:"access_token"`
-	RefreshToken                  types.String `tfsdk:"refresh_token"`
-	Expires                       types.String `tfsdk:"expires"`
-	Name                          types.String `tfsdk:"name"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	AuthUser     types.String `tfsdk:"auth_user"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	Expires      types.String `tfsdk:"expires"`
+	NotificationBase
 }
 
 func (n NotificationTrakt) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		AuthUser:                      n.AuthUser,
-		Name:                          n.Name,
-		AccessToken:                   n.AccessToken,
-		RefreshToken:                  n.RefreshToken,
-		ID:                            n.ID,
-		Expires:                       n.Expires,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationTraktConfigContract),
-		Implementation:                types.StringValue(notificationTraktImplementation),
+		NotificationBase: n.NotificationBase,
+		AuthUser:         n.AuthUser,
+		AccessToken:      n.AccessToken,
+		RefreshToken:     n.RefreshToken,
+		Expires:          n.Expires,
+		ConfigContract:   types.StringValue(notificationTraktConfigContract),
+		Implementation:   types.StringValue(notificationTraktImplementation),
 	}
 }
 
 func (n *NotificationTrakt) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.AuthUser = notification.AuthUser
-	n.Name = notification.Name
 	n.AccessToken = notification.AccessToken
 	n.RefreshToken = notification.RefreshToken
 	n.Expires = notification.Expires
-	n.ID = notification.ID
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationTraktResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -106,6 +79,18 @@ func (r *NotificationTraktResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
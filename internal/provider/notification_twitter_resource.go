@@ -40,79 +40,52 @@ type NotificationTwitterResource struct {
 
 // NotificationTwitter describes the notification data model.
 type NotificationTwitter struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Name                          types.String `tfsdk:"name"`
-	AccessToken                   types.String `tfsdk:"access_token"`
-	AccessTokenSecret             types.String `tfsdk:"access_token_secret"`
-	ConsumerKey                   types.String `tfsdk:"consumer_key"`
-	ConsumerSecret                types.String `tfsdk:"consumer_secret"`
-	Mention                       types.String `tfsdk:"mention"`
-	ID                            types.Int64  `tfsdk:"id"`
-	DirectMessage                 types.Bool   `tfsdk:"direct_message"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	AccessToken       types.String `tfsdk:"access_token"`
+	AccessTokenSecret types.String `tfsdk:"access_token_secret"`
+	ConsumerKey       types.String `tfsdk:"consumer_key"`
+	ConsumerSecret    types.String `tfsdk:"consumer_secret"`
+	Mention           types.String `tfsdk:"mention"`
+	NotificationBase
+	DirectMessage               types.Bool `tfsdk:"direct_message"`
+	OnGrab                      types.Bool `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool `tfsdk:"on_manual_interaction_required"`
 }
 
 func (n NotificationTwitter) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		AccessToken:                   n.AccessToken,
-		AccessTokenSecret:             n.AccessTokenSecret,
-		ConsumerKey:                   n.ConsumerKey,
-		ConsumerSecret:                n.ConsumerSecret,
-		Mention:                       n.Mention,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		DirectMessage:                 n.DirectMessage,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationTwitterConfigContract),
-		Implementation:                types.StringValue(notificationTwitterImplementation),
+		NotificationBase:            n.NotificationBase,
+		AccessToken:                 n.AccessToken,
+		AccessTokenSecret:           n.AccessTokenSecret,
+		ConsumerKey:                 n.ConsumerKey,
+		ConsumerSecret:              n.ConsumerSecret,
+		Mention:                     n.Mention,
+		DirectMessage:               n.DirectMessage,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		ConfigContract:              types.StringValue(notificationTwitterConfigContract),
+		Implementation:              types.StringValue(notificationTwitterImplementation),
 	}
 }
 
 func (n *NotificationTwitter) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.AccessToken = notification.AccessToken
 	n.AccessTokenSecret = notification.AccessTokenSecret
 	n.ConsumerKey = notification.ConsumerKey
 	n.ConsumerSecret = notification.ConsumerSecret
 	n.Mention = notification.Mention
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.DirectMessage = notification.DirectMessage
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationTwitterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -132,6 +105,18 @@ func (r *NotificationTwitterResource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
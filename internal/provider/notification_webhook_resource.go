@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"slices"
 	"strconv"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
@@ -22,6 +23,7 @@ const (
 	notificationWebhookResourceName   = "notification_webhook"
 	notificationWebhookImplementation = "Webhook"
 	notificationWebhookConfigContract = "WebhookSettings"
+	notificationWebhookHeadersField   = "headers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -42,76 +44,50 @@ type NotificationWebhookResource struct {
 
 // NotificationWebhook describes the notification data model.
 type NotificationWebhook struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	URL                           types.String `tfsdk:"url"`
-	Name                          types.String `tfsdk:"name"`
-	Username                      types.String `tfsdk:"username"`
-	Password                      types.String `tfsdk:"password"`
-	ID                            types.Int64  `tfsdk:"id"`
-	Method                        types.Int64  `tfsdk:"method"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	Headers  types.Map    `tfsdk:"headers"`
+	URL      types.String `tfsdk:"url"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	NotificationBase
+	Method                      types.Int64 `tfsdk:"method"`
+	OnGrab                      types.Bool  `tfsdk:"on_grab"`
+	OnApplicationUpdate         types.Bool  `tfsdk:"on_application_update"`
+	OnHealthIssue               types.Bool  `tfsdk:"on_health_issue"`
+	OnHealthRestored            types.Bool  `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired types.Bool  `tfsdk:"on_manual_interaction_required"`
+	OnRename                    types.Bool  `tfsdk:"on_rename"`
 }
 
 func (n NotificationWebhook) toNotification() *Notification {
 	return &Notification{
-		Tags:                          n.Tags,
-		URL:                           n.URL,
-		Method:                        n.Method,
-		Username:                      n.Username,
-		Password:                      n.Password,
-		Name:                          n.Name,
-		ID:                            n.ID,
-		OnGrab:                        n.OnGrab,
-		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
-		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
-		IncludeHealthWarnings:         n.IncludeHealthWarnings,
-		OnApplicationUpdate:           n.OnApplicationUpdate,
-		OnHealthIssue:                 n.OnHealthIssue,
-		OnHealthRestored:              n.OnHealthRestored,
-		OnManualInteractionRequired:   n.OnManualInteractionRequired,
-		OnSeriesAdd:                   n.OnSeriesAdd,
-		OnSeriesDelete:                n.OnSeriesDelete,
-		OnRename:                      n.OnRename,
-		OnUpgrade:                     n.OnUpgrade,
-		OnDownload:                    n.OnDownload,
-		ConfigContract:                types.StringValue(notificationWebhookConfigContract),
-		Implementation:                types.StringValue(notificationWebhookImplementation),
+		NotificationBase:            n.NotificationBase,
+		URL:                         n.URL,
+		Method:                      n.Method,
+		Username:                    n.Username,
+		Password:                    n.Password,
+		OnGrab:                      n.OnGrab,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnManualInteractionRequired: n.OnManualInteractionRequired,
+		OnRename:                    n.OnRename,
+		ConfigContract:              types.StringValue(notificationWebhookConfigContract),
+		Implementation:              types.StringValue(notificationWebhookImplementation),
 	}
 }
 
 func (n *NotificationWebhook) fromNotification(notification *Notification) {
-	n.Tags = notification.Tags
+	n.NotificationBase = notification.NotificationBase
 	n.URL = notification.URL
 	n.Method = notification.Method
 	n.Username = notification.Username
 	n.Password = notification.Password
-	n.Name = notification.Name
-	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
-	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
-	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
-	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
 	n.OnHealthIssue = notification.OnHealthIssue
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
-	n.OnSeriesAdd = notification.OnSeriesAdd
-	n.OnSeriesDelete = notification.OnSeriesDelete
 	n.OnRename = notification.OnRename
-	n.OnUpgrade = notification.OnUpgrade
-	n.OnDownload = notification.OnDownload
 }
 
 func (r *NotificationWebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -131,6 +107,18 @@ func (r *NotificationWebhookResource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_import")),
+				},
+			},
+			"on_import": schema.BoolAttribute{
+				MarkdownDescription: "On import flag, same as `on_download`.",
+				DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					helpers.UseAliasValue(path.Root("on_download")),
+				},
 			},
 			"on_upgrade": schema.BoolAttribute{
 				MarkdownDescription: "On upgrade flag.",
@@ -227,6 +215,13 @@ func (r *NotificationWebhookResource) Schema(_ context.Context, _ resource.Schem
 					int64validator.OneOf(1, 2),
 				},
 			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Headers added to the webhook request, by name.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -344,8 +339,65 @@ func (n *NotificationWebhook) write(ctx context.Context, notification *sonarr.No
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
 	n.fromNotification(genericNotification)
+	n.writeHeaders(ctx, notification.GetFields(), diags)
 }
 
 func (n *NotificationWebhook) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.NotificationResource {
-	return n.toNotification().read(ctx, diags)
+	notification := n.toNotification().read(ctx, diags)
+	notification.Fields = append(notification.Fields, n.readHeaders(ctx, diags)...)
+
+	return notification
+}
+
+// writeHeaders maps the headers key/value list field to the headers map.
+func (n *NotificationWebhook) writeHeaders(ctx context.Context, fields []sonarr.Field, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	headers := make(map[string]string)
+
+	for _, f := range fields {
+		if f.GetName() != notificationWebhookHeadersField {
+			continue
+		}
+
+		values, _ := f.GetValue().([]interface{})
+		for _, v := range values {
+			if header, ok := v.(map[string]interface{}); ok {
+				key, _ := header["key"].(string)
+				value, _ := header["value"].(string)
+				headers[key] = value
+			}
+		}
+	}
+
+	n.Headers, tempDiag = types.MapValueFrom(ctx, types.StringType, headers)
+	diags.Append(tempDiag...)
+}
+
+// readHeaders maps the headers map to the headers key/value list field, sorted by name.
+func (n *NotificationWebhook) readHeaders(ctx context.Context, diags *diag.Diagnostics) []sonarr.Field {
+	if n.Headers.IsNull() || n.Headers.IsUnknown() {
+		return nil
+	}
+
+	headers := make(map[string]string, len(n.Headers.Elements()))
+	diags.Append(n.Headers.ElementsAs(ctx, &headers, false)...)
+
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	values := make([]map[string]string, len(keys))
+	for i, k := range keys {
+		values[i] = map[string]string{"key": k, "value": headers[k]}
+	}
+
+	field := sonarr.NewField()
+	field.SetName(notificationWebhookHeadersField)
+	field.SetValue(values)
+
+	return []sonarr.Field{*field}
 }
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/terraform-provider-sonarr/internal/testutil"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -25,7 +26,8 @@ func TestAccNotificationWebhookResource(t *testing.T) {
 				Config: testAccNotificationWebhookResourceConfig("resourceWebhookTest", "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_notification_webhook.test", "on_upgrade", "false"),
-					resource.TestCheckResourceAttrSet("sonarr_notification_webhook.test", "id"),
+					testutil.CheckNotificationExists("sonarr_notification_webhook.test"),
+					resource.TestCheckResourceAttr("sonarr_notification_webhook.test", "headers.X-Sonarr", "test"),
 				),
 			},
 			// Unauthorized Read
@@ -52,22 +54,47 @@ func TestAccNotificationWebhookResource(t *testing.T) {
 }
 
 func testAccNotificationWebhookResourceConfig(name, upgrade string) string {
+	return testutil.NotificationTestConfig(name, "Webhook", map[string]string{
+		"on_download":                        "true",
+		"on_upgrade":                         upgrade,
+		"on_episode_file_delete_for_upgrade": "true",
+		"headers":                            `{ "X-Sonarr" = "test" }`,
+	})
+}
+
+func TestAccNotificationWebhookResource_onImport(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Conflicting alias
+			{
+				Config:      testAccNotificationWebhookResourceOnImportConfig("on_download = true\n\t\ton_import = false"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be equal"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccNotificationWebhookResourceOnImportConfig("on_import = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_notification_webhook.test", "on_import", "true"),
+					resource.TestCheckResourceAttr("sonarr_notification_webhook.test", "on_download", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationWebhookResourceOnImportConfig(events string) string {
 	return fmt.Sprintf(`
 	resource "sonarr_notification_webhook" "test" {
-		on_grab                            = false
-		on_download                        = true
-		on_upgrade                         = %s
-		on_rename                          = false
-		on_series_delete                   = false
-		on_episode_file_delete             = false
-		on_episode_file_delete_for_upgrade = true
-		on_health_issue                    = false
-		on_application_update              = false
-	  
-		include_health_warnings = false
-		name                    = "%s"
-	  
-		url = "http://transmission:9091"
+		%s
+
+		name = "resourceWebhookOnImport"
+		url  = "http://transmission:9091"
 		method = 1
-	}`, upgrade, name)
+	}`, events)
 }
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitNotificationWebhook_headers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+
+	plan := NotificationWebhook{
+		Headers: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"X-Sonarr":      types.StringValue("test"),
+			"Authorization": types.StringValue("Bearer token"),
+		}),
+	}
+
+	fields := plan.readHeaders(ctx, &diags)
+	if len(fields) != 1 || fields[0].GetName() != "headers" {
+		t.Fatalf("expected a single headers field, got %v", fields)
+	}
+
+	values, _ := fields[0].GetValue().([]map[string]string)
+	if len(values) != 2 || values[0]["key"] != "Authorization" || values[1]["key"] != "X-Sonarr" {
+		t.Errorf("expected headers sorted by name, got %v", values)
+	}
+
+	// Sonarr returns the key/value list decoded as generic JSON
+	field := sonarr.NewField()
+	field.SetName("headers")
+	field.SetValue([]interface{}{
+		map[string]interface{}{"key": "Authorization", "value": "Bearer token"},
+		map[string]interface{}{"key": "X-Sonarr", "value": "test"},
+	})
+
+	var state NotificationWebhook
+
+	state.writeHeaders(ctx, []sonarr.Field{*field}, &diags)
+
+	if !state.Headers.Equal(plan.Headers) {
+		t.Errorf("expected headers to round-trip, got %s", state.Headers)
+	}
+
+	if (&NotificationWebhook{Headers: types.MapNull(types.StringType)}).readHeaders(ctx, &diags) != nil {
+		t.Error("expected no headers field when headers are not set")
+	}
+
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
@@ -59,6 +59,11 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							MarkdownDescription: "On download flag.",
 							Computed:            true,
 						},
+						"on_import": schema.BoolAttribute{
+							MarkdownDescription: "On import flag, same as `on_download`.",
+							DeprecationMessage:  "Use on_download instead, renamed in Sonarr v4",
+							Computed:            true,
+						},
 						"on_upgrade": schema.BoolAttribute{
 							MarkdownDescription: "On upgrade flag.",
 							Computed:            true,
@@ -272,6 +277,10 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							MarkdownDescription: "Chat ID.",
 							Computed:            true,
 						},
+						"topic_id": schema.Int64Attribute{
+							MarkdownDescription: "Topic ID, to send messages to a forum topic of the chat.",
+							Computed:            true,
+						},
 						"consumer_key": schema.StringAttribute{
 							MarkdownDescription: "Consumer key.",
 							Computed:            true,
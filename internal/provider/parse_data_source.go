@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const parseDataSourceName = "parse"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ParseDataSource{}
+
+func NewParseDataSource() datasource.DataSource {
+	return &ParseDataSource{}
+}
+
+// ParseDataSource defines the parse implementation.
+type ParseDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// Parse describes the parse data model.
+type Parse struct {
+	EpisodeNumbers types.List   `tfsdk:"episode_numbers"`
+	Languages      types.List   `tfsdk:"languages"`
+	ID             types.String `tfsdk:"id"`
+	Title          types.String `tfsdk:"title"`
+	SeriesTitle    types.String `tfsdk:"series_title"`
+	Quality        types.String `tfsdk:"quality"`
+	SeasonNumber   types.Int64  `tfsdk:"season_number"`
+}
+
+func (d *ParseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + parseDataSourceName
+}
+
+func (d *ParseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nParse a release title the way Sonarr does, to check why a release does or does not match.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Release title.",
+				Required:            true,
+			},
+			"series_title": schema.StringAttribute{
+				MarkdownDescription: "Parsed series title.",
+				Computed:            true,
+			},
+			"season_number": schema.Int64Attribute{
+				MarkdownDescription: "Parsed season number.",
+				Computed:            true,
+			},
+			"episode_numbers": schema.ListAttribute{
+				MarkdownDescription: "Parsed episode numbers.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"quality": schema.StringAttribute{
+				MarkdownDescription: "Parsed quality name.",
+				Computed:            true,
+			},
+			"languages": schema.ListAttribute{
+				MarkdownDescription: "Parsed language names.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ParseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *ParseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Parse
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get parse current value
+	response, _, err := d.client.ParseAPI.GetParse(d.auth).Title(data.Title.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, parseDataSourceName, err))
+
+		return
+	}
+
+	if response.ParsedEpisodeInfo == nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(parseDataSourceName, "title", data.Title.ValueString()))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+parseDataSourceName)
+	data.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *Parse) write(ctx context.Context, parse *sonarr.ParseResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	info := parse.GetParsedEpisodeInfo()
+	quality := info.GetQuality()
+	qualityInfo := quality.GetQuality()
+
+	languages := make([]string, len(parse.GetLanguages()))
+	for i, l := range parse.GetLanguages() {
+		languages[i] = l.GetName()
+	}
+
+	p.ID = p.Title
+	p.SeriesTitle = types.StringValue(info.GetSeriesTitle())
+	p.SeasonNumber = types.Int64Value(int64(info.GetSeasonNumber()))
+	p.Quality = types.StringValue(qualityInfo.GetName())
+	p.EpisodeNumbers, tempDiag = types.ListValueFrom(ctx, types.Int64Type, info.GetEpisodeNumbers())
+	diags.Append(tempDiag...)
+	p.Languages, tempDiag = types.ListValueFrom(ctx, types.StringType, languages)
+	diags.Append(tempDiag...)
+}
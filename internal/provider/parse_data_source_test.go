@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccParseDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccParseDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccParseDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_parse.test", "series_title", "Breaking Bad"),
+					resource.TestCheckResourceAttr("data.sonarr_parse.test", "season_number", "1"),
+					resource.TestCheckResourceAttr("data.sonarr_parse.test", "episode_numbers.0", "2"),
+					resource.TestCheckResourceAttr("data.sonarr_parse.test", "quality", "WEBDL-1080p"),
+				),
+			},
+		},
+	})
+}
+
+const testAccParseDataSourceConfig = `
+data "sonarr_parse" "test" {
+	title = "Breaking.Bad.S01E02.1080p.WEB-DL.DD5.1.H.264-GROUP"
+}
+`
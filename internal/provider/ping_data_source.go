@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const pingDataSourceName = "ping"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PingDataSource{}
+
+func NewPingDataSource() datasource.DataSource {
+	return &PingDataSource{}
+}
+
+// PingDataSource defines the ping implementation.
+type PingDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// Ping describes the ping data model.
+type Ping struct {
+	ID                types.String `tfsdk:"id"`
+	Status            types.String `tfsdk:"status"`
+	Reachable         types.Bool   `tfsdk:"reachable"`
+	FailOnUnreachable types.Bool   `tfsdk:"fail_on_unreachable"`
+}
+
+func (d *PingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + pingDataSourceName
+}
+
+func (d *PingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nPing Sonarr to check that it is up, without requiring a valid API key.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"fail_on_unreachable": schema.BoolAttribute{
+				MarkdownDescription: "Return an error when Sonarr is unreachable. Default to `false`.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Ping status, empty if unreachable.",
+				Computed:            true,
+			},
+			"reachable": schema.BoolAttribute{
+				MarkdownDescription: "Reachable flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *PingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Ping
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get ping current value
+	response, _, err := d.client.PingAPI.GetPing(d.auth).Execute()
+	if err != nil {
+		if data.FailOnUnreachable.ValueBool() {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, pingDataSourceName, err))
+
+			return
+		}
+
+		tflog.Debug(ctx, "unreachable "+pingDataSourceName+": "+err.Error())
+	}
+
+	tflog.Trace(ctx, "read "+pingDataSourceName)
+	data.ID = types.StringValue(pingDataSourceName)
+	data.Reachable = types.BoolValue(err == nil)
+	data.Status = types.StringValue(response.GetStatus())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPingDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unreachable
+			{
+				Config: testAccPingDataSourceConfig("false") + testUnreachableProvider,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_ping.test", "reachable", "false"),
+					resource.TestCheckResourceAttr("data.sonarr_ping.test", "status", "")),
+			},
+			// Unreachable error
+			{
+				Config:      testAccPingDataSourceConfig("true") + testUnreachableProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing without API key check
+			{
+				Config: testAccPingDataSourceConfig("true") + testUnauthorizedProvider,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_ping.test", "reachable", "true"),
+					resource.TestCheckResourceAttr("data.sonarr_ping.test", "status", "OK")),
+			},
+		},
+	})
+}
+
+const testUnreachableProvider = `
+provider "sonarr" {
+	url = "http://localhost:1"
+	api_key = "ErrorAPIKey"
+}
+`
+
+func testAccPingDataSourceConfig(fail string) string {
+	return fmt.Sprintf(`
+data "sonarr_ping" "test" {
+	fail_on_unreachable = %s
+}
+`, fail)
+}
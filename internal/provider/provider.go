@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/url"
 	"os"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // needed for tf debug mode
@@ -45,8 +47,9 @@ type ExtraHeader struct {
 
 // SonarrData defines auth and client to be used when connecting to Sonarr.
 type SonarrData struct {
-	Auth   context.Context
-	Client *sonarr.APIClient
+	Auth       context.Context
+	Client     *sonarr.APIClient
+	QualityIDs *QualityIDsCache
 }
 
 func (p *SonarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -102,16 +105,28 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		APIURL = os.Getenv("SONARR_URL")
 	}
 
+	if APIURL == "" {
+		resp.Diagnostics.AddError(
+			"Unable to find URL",
+			"URL cannot be empty; set `url` in the provider block or `SONARR_URL` environment variable",
+		)
+
+		return
+	}
+
 	parsedAPIURL, err := url.Parse(APIURL)
-	if err != nil {
+	if err != nil || parsedAPIURL.Scheme == "" || parsedAPIURL.Host == "" {
 		resp.Diagnostics.AddError(
 			"Unable to find valid URL",
-			"URL cannot parsed",
+			fmt.Sprintf("URL '%s' cannot be parsed, it must include protocol and host like 'http://localhost:8989'", APIURL),
 		)
 
 		return
 	}
 
+	// Standard ports are implied by the protocol, no default Sonarr port is added
+	tflog.Debug(ctx, "Sonarr base URL: "+effectiveURL(parsedAPIURL))
+
 	// Extract key
 	key := data.APIKey.ValueString()
 	if key == "" {
@@ -121,7 +136,7 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	if key == "" {
 		resp.Diagnostics.AddError(
 			"Unable to find API key",
-			"API key cannot be an empty string",
+			"API key cannot be empty; set `api_key` in the provider block or `SONARR_API_KEY` environment variable",
 		)
 
 		return
@@ -161,13 +176,27 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	})
 
 	sonarrData := SonarrData{
-		Auth:   auth,
-		Client: sonarr.NewAPIClient(config),
+		Auth:       auth,
+		Client:     sonarr.NewAPIClient(config),
+		QualityIDs: &QualityIDsCache{},
 	}
 	resp.DataSourceData = &sonarrData
 	resp.ResourceData = &sonarrData
 }
 
+// effectiveURL returns the base URL used by the client, with the port implied by the protocol when omitted and the base path kept.
+func effectiveURL(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+
+	return u.Scheme + "://" + net.JoinHostPort(u.Hostname(), port) + u.Path
+}
+
 func (p *SonarrProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		// Download Clients
@@ -205,6 +234,7 @@ func (p *SonarrProvider) Resources(_ context.Context) []func() resource.Resource
 		NewIndexerTorrentRssResource,
 		NewIndexerTorrentleechResource,
 		NewIndexerTorznabResource,
+		NewReleaseResource,
 
 		// Import Lists
 		NewImportListExclusionResource,
@@ -271,6 +301,7 @@ func (p *SonarrProvider) Resources(_ context.Context) []func() resource.Resource
 
 		// Tags
 		NewTagResource,
+		NewTagsSetResource,
 		NewAutoTagResource,
 	}
 }
@@ -288,6 +319,7 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewIndexerConfigDataSource,
 		NewIndexerDataSource,
 		NewIndexersDataSource,
+		NewReleaseDataSource,
 
 		// Import Lists
 		NewImportListExclusionDataSource,
@@ -298,8 +330,11 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		// Media Management
 		NewMediaManagementDataSource,
 		NewNamingDataSource,
+		NewRenamePreviewDataSource,
+		NewManualImportDataSource,
 		NewRootFolderDataSource,
 		NewRootFoldersDataSource,
+		NewUnmappedFoldersDataSource,
 
 		// Metadata
 		NewMetadataConsumersDataSource,
@@ -314,6 +349,7 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewCustomFormatsDataSource,
 		NewDelayProfileDataSource,
 		NewDelayProfilesDataSource,
+		NewLanguageProfileDataSource,
 		NewQualityProfileDataSource,
 		NewQualityProfilesDataSource,
 		NewReleaseProfileDataSource,
@@ -333,16 +369,27 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewSeriesDataSource,
 		NewAllSeriessDataSource,
 		NewSearchSeriesDataSource,
+		NewCalendarDataSource,
+		NewWantedMissingDataSource,
+		NewCutoffUnmetDataSource,
+		NewParseDataSource,
 
 		// System
 		NewLanguageDataSource,
 		NewLanguagesDataSource,
 		NewSystemStatusDataSource,
 		NewHostDataSource,
+		NewHealthDataSource,
+		NewConfigExportDataSource,
+		NewPingDataSource,
+		NewHistoryDataSource,
+		NewQueueDataSource,
 
 		// Tags
 		NewTagDataSource,
 		NewTagsDataSource,
+		NewTagDetailsDataSource,
+		NewAllTagDetailsDataSource,
 		NewAutoTagDataSource,
 		NewAutoTagsDataSource,
 		NewAutoTagConditionDataSource,
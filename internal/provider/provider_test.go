@@ -1,12 +1,19 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/url"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -29,6 +36,33 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccPreCheckVersion skips the test if the Sonarr major version is not the given one.
+func testAccPreCheckVersion(t *testing.T, major string) {
+	t.Helper()
+	testAccPreCheck(t)
+
+	status, _, err := testAccAPIClient().SystemAPI.GetSystemStatus(context.Background()).Execute()
+	if err != nil {
+		t.Fatalf("cannot read Sonarr version: %s", err)
+	}
+
+	if !strings.HasPrefix(status.GetVersion(), major+".") {
+		t.Skipf("Sonarr v%s required, found %s", major, status.GetVersion())
+	}
+}
+
+// testAccImportStateIDFromAttribute uses the given attribute as import identifier.
+func testAccImportStateIDFromAttribute(name, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+
+		return rs.Primary.Attributes[attribute], nil
+	}
+}
+
 func testAccAPIClient() *sonarr.APIClient {
 	config := sonarr.NewConfiguration()
 	config.AddDefaultHeader("X-Api-Key", os.Getenv("SONARR_API_KEY"))
@@ -49,3 +83,102 @@ provider "sonarr" {
 	]
   }
 `
+
+// testUnitProvider configures the provider against a mock Sonarr server.
+func testUnitProvider(url, key string) string {
+	return fmt.Sprintf(`
+provider "sonarr" {
+	url = "%s"
+	api_key = "%s"
+}
+`, url, key)
+}
+
+// testUnitAPIClient returns a client for the mock Sonarr server, to unit test resources without Terraform.
+func testUnitAPIClient(t *testing.T, serverURL, key string) (context.Context, *sonarr.APIClient) {
+	t.Helper()
+
+	parsedURL, err := url.Parse(serverURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auth := context.WithValue(context.Background(), sonarr.ContextAPIKeys, map[string]sonarr.APIKey{
+		"X-Api-Key": {Key: key},
+	})
+	auth = context.WithValue(auth, sonarr.ContextServerVariables, map[string]string{
+		"protocol": parsedURL.Scheme,
+		"hostpath": parsedURL.Host,
+	})
+
+	return auth, sonarr.NewAPIClient(sonarr.NewConfiguration())
+}
+
+func TestUnitProvider_invalidURL(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testUnitProvider("localhost:8989", "key") + testUnitProviderDataSourceConfig,
+				ExpectError: regexp.MustCompile("must include protocol and host"),
+			},
+		},
+	})
+}
+
+const testUnitProviderDataSourceConfig = `
+data "sonarr_system_status" "test" {
+}
+`
+
+func TestUnitProvider_effectiveURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		url      string
+		expected string
+	}{
+		"http_port": {
+			url:      "http://localhost:8989",
+			expected: "http://localhost:8989",
+		},
+		"https_port": {
+			url:      "https://sonarr.example.com:8443",
+			expected: "https://sonarr.example.com:8443",
+		},
+		"http": {
+			url:      "http://sonarr.example.com",
+			expected: "http://sonarr.example.com:80",
+		},
+		"https": {
+			url:      "https://sonarr.example.com",
+			expected: "https://sonarr.example.com:443",
+		},
+		"base_path": {
+			url:      "https://host/sonarr",
+			expected: "https://host:443/sonarr",
+		},
+		"ipv6": {
+			url:      "http://[::1]",
+			expected: "http://[::1]:80",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := url.Parse(test.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if actual := effectiveURL(parsed); actual != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"slices"
 	"strconv"
+	"sync"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -23,8 +25,11 @@ const qualityProfileResourceName = "quality_profile"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &QualityProfileResource{}
-	_ resource.ResourceWithImportState = &QualityProfileResource{}
+	_ resource.Resource                     = &QualityProfileResource{}
+	_ resource.ResourceWithImportState      = &QualityProfileResource{}
+	_ resource.ResourceWithConfigValidators = &QualityProfileResource{}
+	_ resource.ConfigValidator              = QualityProfileUpgradeAllowedValidator{}
+	_ resource.ConfigValidator              = QualityProfileCutoffValidator{}
 )
 
 func NewQualityProfileResource() resource.Resource {
@@ -33,8 +38,17 @@ func NewQualityProfileResource() resource.Resource {
 
 // QualityProfileResource defines the quality profile implementation.
 type QualityProfileResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client     *sonarr.APIClient
+	auth       context.Context
+	qualityIDs *QualityIDsCache
+}
+
+// QualityIDsCache keeps the quality IDs for the whole provider run.
+// Qualities are built into Sonarr, quality definition updates only change titles and sizes,
+// so the cache is never invalidated. Failed lookups are not cached.
+type QualityIDsCache struct {
+	ids []int32
+	mu  sync.Mutex
 }
 
 // QualityProfile describes the quality profile data model.
@@ -120,7 +134,7 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 			},
 			"cutoff": schema.Int64Attribute{
-				MarkdownDescription: "Quality ID to which cutoff.",
+				MarkdownDescription: "Quality ID to which cutoff. It must match one of the allowed qualities or quality groups.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -142,7 +156,7 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 			"format_items": schema.SetNestedAttribute{
-				MarkdownDescription: "Format items. Only the ones with score > 0 are needed.",
+				MarkdownDescription: "Format items. Only the ones with score different from 0 are needed.",
 				Optional:            true,
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
@@ -153,6 +167,13 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *QualityProfileResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		QualityProfileUpgradeAllowedValidator{},
+		QualityProfileCutoffValidator{},
+	}
+}
+
 func (r QualityProfileResource) getQualityGroupSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -221,17 +242,17 @@ func (r QualityProfileResource) getFormatItemsSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"format": schema.Int64Attribute{
-				MarkdownDescription: "Format.",
+				MarkdownDescription: "Custom format ID.",
 				Optional:            true,
 				Computed:            true,
 			},
 			"score": schema.Int64Attribute{
-				MarkdownDescription: "Score.",
+				MarkdownDescription: "Custom format score.",
 				Optional:            true,
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name.",
+				MarkdownDescription: "Custom format name.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -244,6 +265,10 @@ func (r *QualityProfileResource) Configure(ctx context.Context, req resource.Con
 		r.client = client
 		r.auth = auth
 	}
+
+	if data, ok := req.ProviderData.(*SonarrData); ok {
+		r.qualityIDs = data.QualityIDs
+	}
 }
 
 func (r *QualityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -256,6 +281,11 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Check cutoff is one of the allowed qualities
+	if profile.validateCutoff(ctx, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build Create resource
 	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
 
@@ -307,6 +337,11 @@ func (r *QualityProfileResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	// Check cutoff is one of the allowed qualities
+	if profile.validateCutoff(ctx, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build Update resource
 	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
 
@@ -346,7 +381,16 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *QualityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), qualityProfileResourceName, req, resp, func() (map[string]int, error) {
+		profiles, _, err := r.client.QualityProfileAPI.ListQualityProfile(r.auth).Execute()
+		names := make(map[string]int, len(profiles))
+
+		for _, p := range profiles {
+			names[p.GetName()] = int(p.GetId())
+		}
+
+		return names, err
+	})
 	tflog.Trace(ctx, "imported "+qualityProfileResourceName+": "+req.ID)
 }
 
@@ -466,6 +510,7 @@ func (p *QualityProfile) read(ctx context.Context, qualitiesIDs []int32, formatI
 	formatItems := make([]sonarr.ProfileFormatItemResource, 0, len(formatIDs))
 	for _, f := range formats {
 		formatItems = append(formatItems, *f.read())
+		allowedFormats = append(allowedFormats, int32(f.Format.ValueInt64()))
 	}
 
 	// Fill with irrelevant formats
@@ -549,13 +594,124 @@ func (f *FormatItem) read() *sonarr.ProfileFormatItemResource {
 	return formatItem
 }
 
+func (p *QualityProfile) validateCutoff(ctx context.Context, diags *diag.Diagnostics) {
+	if p.Cutoff.IsNull() || p.Cutoff.IsUnknown() || p.QualityGroups.IsUnknown() {
+		return
+	}
+
+	groups := make([]QualityGroup, len(p.QualityGroups.Elements()))
+	diags.Append(p.QualityGroups.ElementsAs(ctx, &groups, false)...)
+
+	// Single quality groups are sent as plain qualities, so the cutoff must match the quality ID
+	allowedIDs := make([]int64, 0, len(groups))
+
+	for _, g := range groups {
+		// Skip validation if the allowed IDs are not known yet
+		if g.Qualities.IsUnknown() {
+			return
+		}
+
+		qualities := make([]Quality, len(g.Qualities.Elements()))
+		diags.Append(g.Qualities.ElementsAs(ctx, &qualities, false)...)
+
+		id := g.ID
+		if len(qualities) == 1 {
+			id = qualities[0].ID
+		}
+
+		if id.IsUnknown() {
+			return
+		}
+
+		allowedIDs = append(allowedIDs, id.ValueInt64())
+	}
+
+	if !slices.Contains(allowedIDs, p.Cutoff.ValueInt64()) {
+		diags.AddAttributeError(
+			path.Root("cutoff"),
+			helpers.ResourceError,
+			fmt.Sprintf("Cutoff %d is not an allowed quality or quality group, must be one of %v", p.Cutoff.ValueInt64(), allowedIDs),
+		)
+	}
+}
+
+// QualityProfileUpgradeAllowedValidator warns when a cutoff is set but upgrades are not allowed.
+type QualityProfileUpgradeAllowedValidator struct{}
+
+func (v QualityProfileUpgradeAllowedValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v QualityProfileUpgradeAllowedValidator) MarkdownDescription(_ context.Context) string {
+	return "Cutoff is only used when upgrade_allowed is true."
+}
+
+func (v QualityProfileUpgradeAllowedValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var upgradeAllowed types.Bool
+
+	var cutoff types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("upgrade_allowed"), &upgradeAllowed)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cutoff"), &cutoff)...)
+
+	if resp.Diagnostics.HasError() || upgradeAllowed.IsNull() || upgradeAllowed.IsUnknown() || cutoff.IsNull() || cutoff.IsUnknown() {
+		return
+	}
+
+	if !upgradeAllowed.ValueBool() && cutoff.ValueInt64() != 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("cutoff"),
+			helpers.ResourceWarning,
+			fmt.Sprintf("Cutoff %d has no effect since upgrade_allowed is false", cutoff.ValueInt64()),
+		)
+	}
+}
+
+// QualityProfileCutoffValidator checks the cutoff is one of the allowed qualities.
+type QualityProfileCutoffValidator struct{}
+
+func (v QualityProfileCutoffValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v QualityProfileCutoffValidator) MarkdownDescription(_ context.Context) string {
+	return "Cutoff must match one of the allowed qualities or quality groups."
+}
+
+func (v QualityProfileCutoffValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var profile *QualityProfile
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &profile)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile.validateCutoff(ctx, &resp.Diagnostics)
+}
+
 func (r QualityProfileResource) getQualityIDs(diags *diag.Diagnostics) []int32 {
+	if r.qualityIDs == nil {
+		return r.listQualityIDs(diags)
+	}
+
+	r.qualityIDs.mu.Lock()
+	defer r.qualityIDs.mu.Unlock()
+
+	if r.qualityIDs.ids == nil {
+		r.qualityIDs.ids = r.listQualityIDs(diags)
+	}
+
+	return r.qualityIDs.ids
+}
+
+func (r QualityProfileResource) listQualityIDs(diags *diag.Diagnostics) []int32 {
 	// Get qualitydefinitions current value
 	qualities, _, err := r.client.QualityDefinitionAPI.ListQualityDefinition(r.auth).Execute()
 	if err != nil {
 		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionsDataSourceName, err))
 
-		return []int32{}
+		return nil
 	}
 
 	// Generate a list of quality IDs
@@ -15,6 +15,12 @@ func TestAccQualityProfileResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid cutoff
+			{
+				Config:      testAccQualityProfileResourceInvalidCutoff,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Cutoff 3000 is not an allowed quality or quality group"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccQualityProfileResourceError + testUnauthorizedProvider,
@@ -25,6 +31,8 @@ func TestAccQualityProfileResource(t *testing.T) {
 				Config: testAccQualityProfileResourceConfig("example-4k"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_quality_profile.test", "name", "example-4k"),
+					resource.TestCheckResourceAttr("sonarr_quality_profile.test", "min_format_score", "5"),
+					resource.TestCheckResourceAttr("sonarr_quality_profile.test", "cutoff_format_score", "10"),
 					resource.TestCheckResourceAttrSet("sonarr_quality_profile.test", "id"),
 				),
 			},
@@ -46,6 +54,13 @@ func TestAccQualityProfileResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "sonarr_quality_profile.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFromAttribute("sonarr_quality_profile.test", "name"),
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -55,8 +70,39 @@ const testAccQualityProfileResourceError = `
 resource "sonarr_quality_profile" "test" {
 	name            = "Error"
 	upgrade_allowed = true
-	cutoff          = 2000
-	quality_groups = []
+	cutoff          = 1
+	quality_groups = [
+		{
+			qualities = [
+				{
+					id         = 1
+					name       = "SDTV"
+					source     = "television"
+					resolution = 480
+				}
+			]
+		}
+	]
+}
+`
+
+const testAccQualityProfileResourceInvalidCutoff = `
+resource "sonarr_quality_profile" "test" {
+	name            = "InvalidCutoff"
+	upgrade_allowed = true
+	cutoff          = 3000
+	quality_groups = [
+		{
+			qualities = [
+				{
+					id         = 1
+					name       = "SDTV"
+					source     = "television"
+					resolution = 480
+				}
+			]
+		}
+	]
 }
 `
 
@@ -94,6 +140,9 @@ func testAccQualityProfileResourceConfig(name string) string {
 		upgrade_allowed = true
 		cutoff          = 2000
 
+		min_format_score    = 5
+		cutoff_format_score = 10
+
 		quality_groups = [
 			{
 				id   = 2000
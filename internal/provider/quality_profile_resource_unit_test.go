@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitQualityProfile_readFormatScores(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+
+	formats, tempDiag := types.SetValueFrom(ctx, FormatItem{}.getType(), []FormatItem{
+		{Name: types.StringValue("x265"), Format: types.Int64Value(2), Score: types.Int64Value(100)},
+	})
+	diags.Append(tempDiag...)
+
+	profile := QualityProfile{
+		FormatItems:   formats,
+		QualityGroups: types.ListValueMust(QualityGroup{}.getType(), []attr.Value{}),
+	}
+
+	scores := make(map[int32]int32)
+	for _, f := range profile.read(ctx, nil, []int32{1, 2, 3}, &diags).GetFormatItems() {
+		if _, ok := scores[f.GetFormat()]; ok {
+			t.Errorf("format %d sent more than once", f.GetFormat())
+		}
+
+		scores[f.GetFormat()] = f.GetScore()
+	}
+
+	if len(scores) != 3 || scores[1] != 0 || scores[2] != 100 || scores[3] != 0 {
+		t.Errorf("expected only the configured format to keep its score, got %v", scores)
+	}
+
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
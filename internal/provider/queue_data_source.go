@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	queueDataSourceName = "queue"
+	queuePageSize       = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QueueDataSource{}
+
+func NewQueueDataSource() datasource.DataSource {
+	return &QueueDataSource{}
+}
+
+// QueueDataSource defines the queue implementation.
+type QueueDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// Queue describes the queue data model.
+type Queue struct {
+	TotalSize     types.Float64 `tfsdk:"total_size"`
+	TotalSizeleft types.Float64 `tfsdk:"total_sizeleft"`
+	Records       types.List    `tfsdk:"records"`
+	Status        types.Set     `tfsdk:"status"`
+	ID            types.String  `tfsdk:"id"`
+}
+
+// QueueRecord is part of Queue.
+type QueueRecord struct {
+	Size           types.Float64 `tfsdk:"size"`
+	Sizeleft       types.Float64 `tfsdk:"sizeleft"`
+	Title          types.String  `tfsdk:"title"`
+	Status         types.String  `tfsdk:"status"`
+	Protocol       types.String  `tfsdk:"protocol"`
+	DownloadClient types.String  `tfsdk:"download_client"`
+	Indexer        types.String  `tfsdk:"indexer"`
+	DownloadID     types.String  `tfsdk:"download_id"`
+	ID             types.Int64   `tfsdk:"id"`
+	SeriesID       types.Int64   `tfsdk:"series_id"`
+	EpisodeID      types.Int64   `tfsdk:"episode_id"`
+}
+
+func (q QueueRecord) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"id":              types.Int64Type,
+			"series_id":       types.Int64Type,
+			"episode_id":      types.Int64Type,
+			"size":            types.Float64Type,
+			"sizeleft":        types.Float64Type,
+			"title":           types.StringType,
+			"status":          types.StringType,
+			"protocol":        types.StringType,
+			"download_client": types.StringType,
+			"indexer":         types.StringType,
+			"download_id":     types.StringType,
+		})
+}
+
+func (d *QueueDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + queueDataSourceName
+}
+
+func (d *QueueDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nList the items in the download queue.\nFor more information refer to [Queue](https://wiki.servarr.com/sonarr/activity#queue) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"status": schema.SetAttribute{
+				MarkdownDescription: "Return only the items with one of the given statuses, such as 'downloading', 'queued', 'paused' or 'completed'.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"total_size": schema.Float64Attribute{
+				MarkdownDescription: "Total size in bytes of the returned items.",
+				Computed:            true,
+			},
+			"total_sizeleft": schema.Float64Attribute{
+				MarkdownDescription: "Total size in bytes left to download for the returned items.",
+				Computed:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "Queue items.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Queue item ID.",
+							Computed:            true,
+						},
+						"series_id": schema.Int64Attribute{
+							MarkdownDescription: "Series ID.",
+							Computed:            true,
+						},
+						"episode_id": schema.Int64Attribute{
+							MarkdownDescription: "Episode ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Release title.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Download status.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Download protocol.",
+							Computed:            true,
+						},
+						"download_client": schema.StringAttribute{
+							MarkdownDescription: "Download client name.",
+							Computed:            true,
+						},
+						"indexer": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download ID.",
+							Computed:            true,
+						},
+						"size": schema.Float64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"sizeleft": schema.Float64Attribute{
+							MarkdownDescription: "Size in bytes left to download.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *QueueDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *QueueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Queue
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statuses := make([]string, 0)
+	resp.Diagnostics.Append(data.Status.ElementsAs(ctx, &statuses, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get all queue pages
+	queue := make([]sonarr.QueueResource, 0)
+
+	for page := int32(1); ; page++ {
+		response, _, err := d.client.QueueAPI.GetQueue(d.auth).
+			Page(page).
+			PageSize(queuePageSize).
+			Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, queueDataSourceName, err))
+
+			return
+		}
+
+		tflog.Trace(ctx, "read "+queueDataSourceName+" page "+strconv.Itoa(int(page)))
+
+		for _, item := range response.GetRecords() {
+			if len(statuses) == 0 || slices.ContainsFunc(statuses, func(s string) bool { return strings.EqualFold(s, item.GetStatus()) }) {
+				queue = append(queue, item)
+			}
+		}
+
+		if len(response.GetRecords()) == 0 || page*queuePageSize >= response.GetTotalRecords() {
+			break
+		}
+	}
+
+	// Map response body to resource schema attribute
+	var totalSize, totalSizeleft float64
+
+	records := make([]QueueRecord, len(queue))
+	for i, q := range queue {
+		records[i].write(&q)
+		totalSize += q.GetSize()
+		totalSizeleft += q.GetSizeleft()
+	}
+
+	recordList, diags := types.ListValueFrom(ctx, QueueRecord{}.getType(), records)
+	resp.Diagnostics.Append(diags...)
+
+	data.Records = recordList
+	data.TotalSize = types.Float64Value(totalSize)
+	data.TotalSizeleft = types.Float64Value(totalSizeleft)
+	data.ID = types.StringValue(strconv.Itoa(len(records)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (q *QueueRecord) write(queue *sonarr.QueueResource) {
+	q.ID = types.Int64Value(int64(queue.GetId()))
+	q.SeriesID = types.Int64Value(int64(queue.GetSeriesId()))
+	q.EpisodeID = types.Int64Value(int64(queue.GetEpisodeId()))
+	q.Title = types.StringValue(queue.GetTitle())
+	q.Status = types.StringValue(queue.GetStatus())
+	q.Protocol = types.StringValue(string(queue.GetProtocol()))
+	q.DownloadClient = types.StringValue(queue.GetDownloadClient())
+	q.Indexer = types.StringValue(queue.GetIndexer())
+	q.DownloadID = types.StringValue(queue.GetDownloadId())
+	q.Size = types.Float64Value(queue.GetSize())
+	q.Sizeleft = types.Float64Value(queue.GetSizeleft())
+}
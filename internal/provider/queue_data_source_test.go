@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueueDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccQueueDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccQueueDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_queue.test", "id"),
+					resource.TestCheckResourceAttrSet("data.sonarr_queue.test", "records.#"),
+					resource.TestCheckResourceAttrSet("data.sonarr_queue.test", "total_size"),
+				),
+			},
+			// Status filter
+			{
+				Config: `
+				data "sonarr_queue" "test" {
+					status = ["notAStatus"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_queue.test", "records.#", "0"),
+					resource.TestCheckResourceAttr("data.sonarr_queue.test", "total_size", "0"),
+					resource.TestCheckResourceAttr("data.sonarr_queue.test", "total_sizeleft", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccQueueDataSourceConfig = `
+data "sonarr_queue" "test" {
+}
+`
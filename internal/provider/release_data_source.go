@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const releaseDataSourceName = "release"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ReleaseDataSource{}
+
+func NewReleaseDataSource() datasource.DataSource {
+	return &ReleaseDataSource{}
+}
+
+// ReleaseDataSource defines the release implementation.
+type ReleaseDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// Releases describes the release data model.
+type Releases struct {
+	Releases     types.List   `tfsdk:"releases"`
+	ID           types.String `tfsdk:"id"`
+	SeriesID     types.Int64  `tfsdk:"series_id"`
+	SeasonNumber types.Int64  `tfsdk:"season_number"`
+	EpisodeID    types.Int64  `tfsdk:"episode_id"`
+}
+
+// Release is part of Releases.
+type Release struct {
+	Rejections types.List   `tfsdk:"rejections"`
+	GUID       types.String `tfsdk:"guid"`
+	Title      types.String `tfsdk:"title"`
+	Indexer    types.String `tfsdk:"indexer"`
+	Quality    types.String `tfsdk:"quality"`
+	IndexerID  types.Int64  `tfsdk:"indexer_id"`
+	Size       types.Int64  `tfsdk:"size"`
+	Seeders    types.Int64  `tfsdk:"seeders"`
+	Rejected   types.Bool   `tfsdk:"rejected"`
+}
+
+func (r Release) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"rejections": types.ListType{}.WithElementType(types.StringType),
+			"guid":       types.StringType,
+			"title":      types.StringType,
+			"indexer":    types.StringType,
+			"quality":    types.StringType,
+			"indexer_id": types.Int64Type,
+			"size":       types.Int64Type,
+			"seeders":    types.Int64Type,
+			"rejected":   types.BoolType,
+		})
+}
+
+func (d *ReleaseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + releaseDataSourceName
+}
+
+func (d *ReleaseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nSearch the indexers for the releases of a season or an episode, without grabbing them.\nFor more information refer to [Interactive Search](https://wiki.servarr.com/sonarr/series#interactive-search) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"series_id": schema.Int64Attribute{
+				MarkdownDescription: "Series ID. Requires `season_number`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("season_number")),
+				},
+			},
+			"season_number": schema.Int64Attribute{
+				MarkdownDescription: "Season number. Requires `series_id`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("series_id")),
+				},
+			},
+			"episode_id": schema.Int64Attribute{
+				MarkdownDescription: "Episode ID. Conflicts with `series_id`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("series_id")),
+				},
+			},
+			"releases": schema.ListNestedAttribute{
+				MarkdownDescription: "Releases found on the indexers.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"guid": schema.StringAttribute{
+							MarkdownDescription: "Release GUID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Release title.",
+							Computed:            true,
+						},
+						"indexer": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"indexer_id": schema.Int64Attribute{
+							MarkdownDescription: "Indexer ID.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"seeders": schema.Int64Attribute{
+							MarkdownDescription: "Seeders, `0` for usenet releases.",
+							Computed:            true,
+						},
+						"quality": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+						"rejected": schema.BoolAttribute{
+							MarkdownDescription: "Rejected flag.",
+							Computed:            true,
+						},
+						"rejections": schema.ListAttribute{
+							MarkdownDescription: "Rejection reasons.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ReleaseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *ReleaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Releases
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := d.client.ReleaseAPI.ListRelease(d.auth)
+	if !data.EpisodeID.IsNull() {
+		request = request.EpisodeId(int32(data.EpisodeID.ValueInt64()))
+	} else {
+		request = request.SeriesId(int32(data.SeriesID.ValueInt64())).SeasonNumber(int32(data.SeasonNumber.ValueInt64()))
+	}
+
+	// Get releases current value
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, releaseDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+releaseDataSourceName)
+	// Map response body to resource schema attribute
+	releases := make([]Release, len(response))
+	for i, r := range response {
+		releases[i].write(ctx, &r, &resp.Diagnostics)
+	}
+
+	releaseList, diags := types.ListValueFrom(ctx, Release{}.getType(), releases)
+	resp.Diagnostics.Append(diags...)
+
+	data.Releases = releaseList
+	data.ID = types.StringValue(strconv.Itoa(len(releases)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *Release) write(ctx context.Context, release *sonarr.ReleaseResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	quality := release.GetQuality()
+	qualityInfo := quality.GetQuality()
+
+	r.GUID = types.StringValue(release.GetGuid())
+	r.Title = types.StringValue(release.GetTitle())
+	r.Indexer = types.StringValue(release.GetIndexer())
+	r.IndexerID = types.Int64Value(int64(release.GetIndexerId()))
+	r.Size = types.Int64Value(release.GetSize())
+	r.Seeders = types.Int64Value(int64(release.GetSeeders()))
+	r.Quality = types.StringValue(qualityInfo.GetName())
+	r.Rejected = types.BoolValue(release.GetRejected())
+	r.Rejections, tempDiag = types.ListValueFrom(ctx, types.StringType, release.GetRejections())
+	diags.Append(tempDiag...)
+}
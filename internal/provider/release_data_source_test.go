@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReleaseDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing season number
+			{
+				Config:      testAccReleaseDataSourceSeriesConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Unauthorized
+			{
+				Config:      testAccReleaseDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+		},
+	})
+}
+
+const testAccReleaseDataSourceConfig = `
+data "sonarr_release" "test" {
+	episode_id = 1
+}
+`
+
+const testAccReleaseDataSourceSeriesConfig = `
+data "sonarr_release" "test" {
+	series_id = 1
+}
+`
@@ -29,7 +29,7 @@ type ReleaseProfilesDataSource struct {
 
 // ReleaseProfiles describes the release profiles data model.
 type ReleaseProfiles struct {
-	ReleaseProfiles types.Set    `tfsdk:"release_profiles"`
+	ReleaseProfiles types.List   `tfsdk:"release_profiles"`
 	ID              types.String `tfsdk:"id"`
 }
 
@@ -46,8 +46,8 @@ func (d *ReleaseProfilesDataSource) Schema(_ context.Context, _ datasource.Schem
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
-			"release_profiles": schema.SetNestedAttribute{
-				MarkdownDescription: "Release Profile list.",
+			"release_profiles": schema.ListNestedAttribute{
+				MarkdownDescription: "Release Profile list, in Sonarr order.",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -112,7 +112,7 @@ func (d *ReleaseProfilesDataSource) Read(ctx context.Context, _ datasource.ReadR
 		profiles[i].write(ctx, &p, &resp.Diagnostics)
 	}
 
-	profileList, diags := types.SetValueFrom(ctx, ReleaseProfile{}.getType(), profiles)
+	profileList, diags := types.ListValueFrom(ctx, ReleaseProfile{}.getType(), profiles)
 	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, ReleaseProfiles{ReleaseProfiles: profileList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
 }
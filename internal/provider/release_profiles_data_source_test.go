@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccReleaseProfilesDataSource(t *testing.T) {
@@ -28,6 +32,7 @@ func TestAccReleaseProfilesDataSource(t *testing.T) {
 				Config: testAccReleaseProfilesDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_release_profiles.test", "release_profiles.*", map[string]string{"name": "testDataSources"}),
+					testAccCheckReleaseProfilesOrder("data.sonarr_release_profiles.test"),
 				),
 			},
 		},
@@ -38,3 +43,27 @@ const testAccReleaseProfilesDataSourceConfig = `
 data "sonarr_release_profiles" "test" {
 }
 `
+
+// testAccCheckReleaseProfilesOrder checks the release profiles are listed in Sonarr order.
+func testAccCheckReleaseProfilesOrder(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("data source not found: %s", name)
+		}
+
+		profiles, _, err := testAccAPIClient().ReleaseProfileAPI.ListReleaseProfile(context.Background()).Execute()
+		if err != nil {
+			return err
+		}
+
+		for i, p := range profiles {
+			attribute := "release_profiles." + strconv.Itoa(i) + ".id"
+			if id := rs.Primary.Attributes[attribute]; id != strconv.Itoa(int(p.GetId())) {
+				return fmt.Errorf("%s expected %d, got %s", attribute, p.GetId(), id)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const releaseResourceName = "release"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ReleaseResource{}
+
+func NewReleaseResource() resource.Resource {
+	return &ReleaseResource{}
+}
+
+// ReleaseResource defines the release implementation.
+type ReleaseResource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// ReleaseGrab describes the release grab data model.
+type ReleaseGrab struct {
+	ID        types.String `tfsdk:"id"`
+	GUID      types.String `tfsdk:"guid"`
+	IndexerID types.Int64  `tfsdk:"indexer_id"`
+	Grabbed   types.Bool   `tfsdk:"grabbed"`
+}
+
+func (r *ReleaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + releaseResourceName
+}
+
+func (r *ReleaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nRelease resource, to grab a release and send it to the download client.\nThe release must come from a recent search, for example the [Release](../data-sources/release) data source. Changing `guid` or `indexer_id` grabs again, destroying only removes it from the state.\nFor more information refer to [Interactive Search](https://wiki.servarr.com/sonarr/series#interactive-search) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"guid": schema.StringAttribute{
+				MarkdownDescription: "Release GUID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"indexer_id": schema.Int64Attribute{
+				MarkdownDescription: "Indexer ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"grabbed": schema.BoolAttribute{
+				MarkdownDescription: "Grabbed flag, set once the release is sent to the download client.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Release ID, same as `guid`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ReleaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *ReleaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var release *ReleaseGrab
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &release)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Grab release
+	request := sonarr.NewReleaseResource()
+	request.SetGuid(release.GUID.ValueString())
+	request.SetIndexerId(int32(release.IndexerID.ValueInt64()))
+
+	_, err := r.client.ReleaseAPI.CreateRelease(r.auth).ReleaseResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, releaseResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+releaseResourceName+": "+release.GUID.ValueString()+" on indexer "+strconv.Itoa(int(release.IndexerID.ValueInt64())))
+	// Generate resource state struct
+	release.ID = release.GUID
+	release.Grabbed = types.BoolValue(true)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &release)...)
+}
+
+func (r *ReleaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Releases cannot be read back once grabbed, keep the current state
+	var release *ReleaseGrab
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &release)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+releaseResourceName+": "+release.GUID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &release)...)
+}
+
+func (r *ReleaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replace, keep the plan
+	var release *ReleaseGrab
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &release)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+releaseResourceName+": "+release.GUID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &release)...)
+}
+
+func (r *ReleaseResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Release grab cannot be undone just removing it from state
+	tflog.Trace(ctx, "decoupled "+releaseResourceName)
+	resp.State.RemoveResource(ctx)
+}
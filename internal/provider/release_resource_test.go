@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReleaseResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccReleaseResourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Release not in the search cache
+			{
+				Config:      testAccReleaseResourceConfig,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+		},
+	})
+}
+
+const testAccReleaseResourceConfig = `
+resource "sonarr_release" "test" {
+	guid       = "missing-release-guid"
+	indexer_id = 1
+}
+`
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const renamePreviewDataSourceName = "rename_preview"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RenamePreviewDataSource{}
+
+func NewRenamePreviewDataSource() datasource.DataSource {
+	return &RenamePreviewDataSource{}
+}
+
+// RenamePreviewDataSource defines the rename preview implementation.
+type RenamePreviewDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// RenamePreview describes the rename preview data model.
+type RenamePreview struct {
+	Renames      types.List   `tfsdk:"renames"`
+	ID           types.String `tfsdk:"id"`
+	SeriesID     types.Int64  `tfsdk:"series_id"`
+	SeasonNumber types.Int64  `tfsdk:"season_number"`
+}
+
+// Rename is part of RenamePreview.
+type Rename struct {
+	EpisodeNumbers types.List   `tfsdk:"episode_numbers"`
+	ExistingPath   types.String `tfsdk:"existing_path"`
+	NewPath        types.String `tfsdk:"new_path"`
+	EpisodeFileID  types.Int64  `tfsdk:"episode_file_id"`
+	SeasonNumber   types.Int64  `tfsdk:"season_number"`
+}
+
+func (r Rename) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"episode_numbers": types.ListType{}.WithElementType(types.Int64Type),
+			"existing_path":   types.StringType,
+			"new_path":        types.StringType,
+			"episode_file_id": types.Int64Type,
+			"season_number":   types.Int64Type,
+		})
+}
+
+func (d *RenamePreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + renamePreviewDataSourceName
+}
+
+func (d *RenamePreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Media Management -->\nList the episode files of a series that the current naming would rename, without renaming them.\nFor more information refer to [Naming](https://wiki.servarr.com/sonarr/settings#community-naming-suggestions) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"series_id": schema.Int64Attribute{
+				MarkdownDescription: "Series ID.",
+				Required:            true,
+			},
+			"season_number": schema.Int64Attribute{
+				MarkdownDescription: "Season number, all seasons if not set.",
+				Optional:            true,
+			},
+			"renames": schema.ListNestedAttribute{
+				MarkdownDescription: "Proposed renames.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"episode_file_id": schema.Int64Attribute{
+							MarkdownDescription: "Episode file ID.",
+							Computed:            true,
+						},
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Season number.",
+							Computed:            true,
+						},
+						"episode_numbers": schema.ListAttribute{
+							MarkdownDescription: "Episode numbers.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"existing_path": schema.StringAttribute{
+							MarkdownDescription: "Current path relative to the series folder.",
+							Computed:            true,
+						},
+						"new_path": schema.StringAttribute{
+							MarkdownDescription: "New path relative to the series folder.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RenamePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *RenamePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *RenamePreview
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := d.client.RenameEpisodeAPI.ListRename(d.auth).SeriesId(int32(data.SeriesID.ValueInt64()))
+	if !data.SeasonNumber.IsNull() {
+		request = request.SeasonNumber(int32(data.SeasonNumber.ValueInt64()))
+	}
+
+	// Get rename preview current value
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, renamePreviewDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+renamePreviewDataSourceName)
+	// Map response body to resource schema attribute
+	renames := make([]Rename, len(response))
+	for i, r := range response {
+		renames[i].write(ctx, &r, &resp.Diagnostics)
+	}
+
+	renameList, diags := types.ListValueFrom(ctx, Rename{}.getType(), renames)
+	resp.Diagnostics.Append(diags...)
+
+	data.Renames = renameList
+	data.ID = types.StringValue(strconv.Itoa(len(renames)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *Rename) write(ctx context.Context, rename *sonarr.RenameEpisodeResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	r.EpisodeFileID = types.Int64Value(int64(rename.GetEpisodeFileId()))
+	r.SeasonNumber = types.Int64Value(int64(rename.GetSeasonNumber()))
+	r.ExistingPath = types.StringValue(rename.GetExistingPath())
+	r.NewPath = types.StringValue(rename.GetNewPath())
+	r.EpisodeNumbers, tempDiag = types.ListValueFrom(ctx, types.Int64Type, rename.GetEpisodeNumbers())
+	diags.Append(tempDiag...)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRenamePreviewDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccRenamePreviewDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccRenamePreviewDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_rename_preview.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_rename_preview.test", "renames.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRenamePreviewDataSourceConfig = `
+resource "sonarr_series" "test" {
+	title      = "Friends"
+	title_slug = "friends"
+	tvdb_id    = 79168
+
+	monitored           = false
+	season_folder       = true
+	use_scene_numbering = false
+	root_folder_path    = "/config"
+
+	quality_profile_id  = 1
+}
+
+data "sonarr_rename_preview" "test" {
+	series_id = sonarr_series.test.id
+}
+`
@@ -43,6 +43,14 @@ func (d *RootFolderDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				MarkdownDescription: "Access flag.",
 				Computed:            true,
 			},
+			"free_space": schema.Int64Attribute{
+				MarkdownDescription: "Free space in bytes.",
+				Computed:            true,
+			},
+			"total_space": schema.Int64Attribute{
+				MarkdownDescription: "Total space in bytes of the disk holding the root folder.",
+				Computed:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Root Folder ID.",
 				Computed:            true,
@@ -91,6 +99,7 @@ func (d *RootFolderDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	folder.find(ctx, folder.Path.ValueString(), response, &resp.Diagnostics)
+	folder.writeTotalSpace(listDiskSpace(d.auth, d.client, &resp.Diagnostics))
 
 	tflog.Trace(ctx, "read "+rootFolderDataSourceName)
 	// Map response body to resource schema attribute
@@ -33,6 +33,8 @@ func TestAccRootFolderDataSource(t *testing.T) {
 				Config:    testAccRootFolderDataSourceConfig("/config"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.sonarr_root_folder.test", "id"),
+					resource.TestCheckResourceAttrSet("data.sonarr_root_folder.test", "free_space"),
+					resource.TestCheckResourceAttrSet("data.sonarr_root_folder.test", "total_space"),
 					resource.TestCheckResourceAttr("data.sonarr_root_folder.test", "path", "/config")),
 			},
 		},
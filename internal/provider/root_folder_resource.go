@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -41,6 +42,8 @@ type RootFolder struct {
 	UnmappedFolders types.Set    `tfsdk:"unmapped_folders"`
 	Path            types.String `tfsdk:"path"`
 	ID              types.Int64  `tfsdk:"id"`
+	FreeSpace       types.Int64  `tfsdk:"free_space"`
+	TotalSpace      types.Int64  `tfsdk:"total_space"`
 	Accessible      types.Bool   `tfsdk:"accessible"`
 }
 
@@ -50,6 +53,8 @@ func (r RootFolder) getType() attr.Type {
 			"unmapped_folders": types.SetType{}.WithElementType(Path{}.getType()),
 			"path":             types.StringType,
 			"id":               types.Int64Type,
+			"free_space":       types.Int64Type,
+			"total_space":      types.Int64Type,
 			"accessible":       types.BoolType,
 		})
 }
@@ -88,6 +93,14 @@ func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "Access flag.",
 				Computed:            true,
 			},
+			"free_space": schema.Int64Attribute{
+				MarkdownDescription: "Free space in bytes.",
+				Computed:            true,
+			},
+			"total_space": schema.Int64Attribute{
+				MarkdownDescription: "Total space in bytes of the disk holding the root folder, null when Sonarr cannot list the disks.",
+				Computed:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Root Folder ID.",
 				Computed:            true,
@@ -152,6 +165,7 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Trace(ctx, "created "+rootFolderResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	folder.write(ctx, response, &resp.Diagnostics)
+	r.writeTotalSpace(ctx, folder)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
 }
 
@@ -176,6 +190,7 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Trace(ctx, "read "+rootFolderResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	folder.write(ctx, response, &resp.Diagnostics)
+	r.writeTotalSpace(ctx, folder)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
 }
 
@@ -213,6 +228,7 @@ func (r *RootFolder) write(ctx context.Context, rootFolder *sonarr.RootFolderRes
 	var tempDiag diag.Diagnostics
 
 	r.Accessible = types.BoolValue(rootFolder.GetAccessible())
+	r.FreeSpace = types.Int64Value(rootFolder.GetFreeSpace())
 	r.ID = types.Int64Value(int64(rootFolder.GetId()))
 	r.Path = types.StringValue(rootFolder.GetPath())
 
@@ -229,3 +245,46 @@ func (p *Path) write(folder *sonarr.UnmappedFolder) {
 	p.Name = types.StringValue(folder.GetName())
 	p.Path = types.StringValue(folder.GetPath())
 }
+
+// writeTotalSpace sets the total space of the disk holding the root folder, the one with the longest matching path.
+// Disks match only on a path separator boundary, so that /data does not hold /database.
+func (r *RootFolder) writeTotalSpace(disks []sonarr.DiskSpaceResource) {
+	var match string
+
+	r.TotalSpace = types.Int64Value(0)
+	folder := trimPathSeparator(r.Path.ValueString())
+
+	for _, d := range disks {
+		disk := trimPathSeparator(d.GetPath())
+		if (folder == disk || strings.HasPrefix(folder, disk+"/") || strings.HasPrefix(folder, disk+"\\")) && len(d.GetPath()) > len(match) {
+			match = d.GetPath()
+			r.TotalSpace = types.Int64Value(d.GetTotalSpace())
+		}
+	}
+}
+
+// writeTotalSpace sets the total space of the folder disk, or null when the disks cannot be listed.
+// The lookup is best-effort: total space is informational and must not fail an existing root folder.
+func (r *RootFolderResource) writeTotalSpace(ctx context.Context, folder *RootFolder) {
+	disks, _, err := r.client.DiskSpaceAPI.ListDiskSpace(r.auth).Execute()
+	if err != nil {
+		tflog.Warn(ctx, "unable to list disk space, total_space is not set: "+err.Error())
+		folder.TotalSpace = types.Int64Null()
+
+		return
+	}
+
+	folder.writeTotalSpace(disks)
+}
+
+// listDiskSpace returns the disks seen by Sonarr.
+func listDiskSpace(auth context.Context, client *sonarr.APIClient, diags *diag.Diagnostics) []sonarr.DiskSpaceResource {
+	disks, _, err := client.DiskSpaceAPI.ListDiskSpace(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, "disk_space", err))
+
+		return nil
+	}
+
+	return disks
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitRootFolder_writeTotalSpace(t *testing.T) {
+	t.Parallel()
+
+	disks := make([]sonarr.DiskSpaceResource, 0, 3)
+
+	for path, space := range map[string]int64{"/": 1, "/data": 2, "D:\\": 3} {
+		disk := sonarr.NewDiskSpaceResource()
+		disk.SetPath(path)
+		disk.SetTotalSpace(space)
+		disks = append(disks, *disk)
+	}
+
+	tests := map[string]struct {
+		path     string
+		expected int64
+	}{
+		"exact": {
+			path:     "/data",
+			expected: 2,
+		},
+		"nested": {
+			path:     "/data/tv/",
+			expected: 2,
+		},
+		"sibling_prefix": {
+			path:     "/database/tv",
+			expected: 1,
+		},
+		"windows": {
+			path:     "D:\\tv",
+			expected: 3,
+		},
+		"no_match": {
+			path:     "E:\\tv",
+			expected: 0,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			folder := RootFolder{Path: types.StringValue(test.path)}
+			folder.writeTotalSpace(disks)
+
+			if folder.TotalSpace.ValueInt64() != test.expected {
+				t.Errorf("expected total space %d, got %s", test.expected, folder.TotalSpace)
+			}
+		})
+	}
+}
@@ -59,6 +59,14 @@ func (d *RootFoldersDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 							MarkdownDescription: "Access flag.",
 							Computed:            true,
 						},
+						"free_space": schema.Int64Attribute{
+							MarkdownDescription: "Free space in bytes.",
+							Computed:            true,
+						},
+						"total_space": schema.Int64Attribute{
+							MarkdownDescription: "Total space in bytes of the disk holding the root folder.",
+							Computed:            true,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Root Folder ID.",
 							Computed:            true,
@@ -104,9 +112,12 @@ func (d *RootFoldersDataSource) Read(ctx context.Context, _ datasource.ReadReque
 
 	tflog.Trace(ctx, "read "+rootFoldersDataSourceName)
 	// Map response body to resource schema attribute
+	disks := listDiskSpace(d.auth, d.client, &resp.Diagnostics)
 	rootFolders := make([]RootFolder, len(response))
+
 	for i, f := range response {
 		rootFolders[i].write(ctx, &f, &resp.Diagnostics)
+		rootFolders[i].writeTotalSpace(disks)
 	}
 
 	folderList, diags := types.SetValueFrom(ctx, RootFolder{}.getType(), rootFolders)
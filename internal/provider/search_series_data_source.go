@@ -51,6 +51,18 @@ func (d *SearchSeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "Monitored flag.",
 				Computed:            true,
 			},
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new items.",
+				Computed:            true,
+			},
+			"next_airing": schema.StringAttribute{
+				MarkdownDescription: "Next airing date in RFC3339 format, empty if none.",
+				Computed:            true,
+			},
+			"previous_airing": schema.StringAttribute{
+				MarkdownDescription: "Previous airing date in RFC3339 format, empty if none.",
+				Computed:            true,
+			},
 			"season_folder": schema.BoolAttribute{
 				MarkdownDescription: "Season Folder flag.",
 				Computed:            true,
@@ -63,6 +75,10 @@ func (d *SearchSeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "Quality Profile ID.",
 				Computed:            true,
 			},
+			"language_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Language Profile ID.",
+				Computed:            true,
+			},
 			"tvdb_id": schema.Int64Attribute{
 				MarkdownDescription: "TVDB ID.",
 				Required:            true,
@@ -71,6 +87,18 @@ func (d *SearchSeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "Series Path.",
 				Computed:            true,
 			},
+			"folder_name": schema.StringAttribute{
+				MarkdownDescription: "Series folder name assigned by Sonarr.",
+				Computed:            true,
+			},
+			"poster_url": schema.StringAttribute{
+				MarkdownDescription: "Poster remote URL, empty if none.",
+				Computed:            true,
+			},
+			"fanart_url": schema.StringAttribute{
+				MarkdownDescription: "Fanart remote URL, empty if none.",
+				Computed:            true,
+			},
 			"root_folder_path": schema.StringAttribute{
 				MarkdownDescription: "Series Root Folder.",
 				Computed:            true,
@@ -80,6 +108,26 @@ func (d *SearchSeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"images": schema.SetNestedAttribute{
+				MarkdownDescription: "Series images.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cover_type": schema.StringAttribute{
+							MarkdownDescription: "Cover type, such as 'poster', 'fanart' or 'banner'.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Image URL on the Sonarr server.",
+							Computed:            true,
+						},
+						"remote_url": schema.StringAttribute{
+							MarkdownDescription: "Image remote URL.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -2,10 +2,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -13,7 +18,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -21,8 +29,10 @@ const seriesResourceName = "series"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &SeriesResource{}
-	_ resource.ResourceWithImportState = &SeriesResource{}
+	_ resource.Resource                     = &SeriesResource{}
+	_ resource.ResourceWithImportState      = &SeriesResource{}
+	_ resource.ResourceWithConfigValidators = &SeriesResource{}
+	_ resource.ConfigValidator              = SeriesRootFolderValidator{}
 )
 
 func NewSeriesResource() resource.Resource {
@@ -38,12 +48,20 @@ type SeriesResource struct {
 // Series describes the series data model.
 type Series struct {
 	Tags              types.Set    `tfsdk:"tags"`
+	Images            types.Set    `tfsdk:"images"`
 	Path              types.String `tfsdk:"path"`
 	Title             types.String `tfsdk:"title"`
 	TitleSlug         types.String `tfsdk:"title_slug"`
 	RootFolderPath    types.String `tfsdk:"root_folder_path"`
+	MonitorNewItems   types.String `tfsdk:"monitor_new_items"`
+	NextAiring        types.String `tfsdk:"next_airing"`
+	PreviousAiring    types.String `tfsdk:"previous_airing"`
+	FolderName        types.String `tfsdk:"folder_name"`
+	PosterURL         types.String `tfsdk:"poster_url"`
+	FanartURL         types.String `tfsdk:"fanart_url"`
 	ID                types.Int64  `tfsdk:"id"`
 	QualityProfileID  types.Int64  `tfsdk:"quality_profile_id"`
+	LanguageProfileID types.Int64  `tfsdk:"language_profile_id"`
 	TvdbID            types.Int64  `tfsdk:"tvdb_id"`
 	Monitored         types.Bool   `tfsdk:"monitored"`
 	SeasonFolder      types.Bool   `tfsdk:"season_folder"`
@@ -58,12 +76,20 @@ func (s Series) getType() attr.Type {
 			"use_scene_numbering": types.BoolType,
 			"id":                  types.Int64Type,
 			"quality_profile_id":  types.Int64Type,
+			"language_profile_id": types.Int64Type,
 			"tvdb_id":             types.Int64Type,
 			"root_folder_path":    types.StringType,
+			"monitor_new_items":   types.StringType,
+			"next_airing":         types.StringType,
+			"previous_airing":     types.StringType,
+			"folder_name":         types.StringType,
+			"poster_url":          types.StringType,
+			"fanart_url":          types.StringType,
 			"title_slug":          types.StringType,
 			"title":               types.StringType,
 			"path":                types.StringType,
 			"tags":                types.SetType{}.WithElementType(types.Int64Type),
+			"images":              types.SetType{}.WithElementType(Image{}.getType()),
 		})
 }
 
@@ -73,12 +99,41 @@ type Season struct {
 	SeasonNumber types.Int64 `tfsdk:"season_number"`
 }
 
+// SeriesWithAddOptions describes the series resource data model, including the creation options.
+type SeriesWithAddOptions struct {
+	AddOptions  types.Object `tfsdk:"add_options"`
+	NextEpisode types.Object `tfsdk:"next_episode"`
+	ActualPath  types.String `tfsdk:"actual_path"`
+	Series
+}
+
+// NextEpisode is part of SeriesWithAddOptions.
+type NextEpisode struct {
+	Title         types.String `tfsdk:"title"`
+	AirDate       types.String `tfsdk:"air_date"`
+	AirDateUtc    types.String `tfsdk:"air_date_utc"`
+	SeasonNumber  types.Int64  `tfsdk:"season_number"`
+	EpisodeNumber types.Int64  `tfsdk:"episode_number"`
+}
+
+func (e NextEpisode) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":          types.StringType,
+			"air_date":       types.StringType,
+			"air_date_utc":   types.StringType,
+			"season_number":  types.Int64Type,
+			"episode_number": types.Int64Type,
+		})
+}
+
 // AddSeriesOptions is used in series creation.
 type AddSeriesOptions struct {
-	SearchForMissingEpisodes     types.Bool `tfsdk:"search_for_missing_episodes"`
-	SearchForCutoffUnmetEpisodes types.Bool `tfsdk:"search_for_cutoff_unmet_episodes"`
-	IgnoreEpisodesWithFiles      types.Bool `tfsdk:"ignore_episodes_with_files"`
-	IgnoreEpisodesWithoutFiles   types.Bool `tfsdk:"ignore_episodes_without_files"`
+	Monitor                      types.String `tfsdk:"monitor"`
+	SearchForMissingEpisodes     types.Bool   `tfsdk:"search_for_missing_episodes"`
+	SearchForCutoffUnmetEpisodes types.Bool   `tfsdk:"search_for_cutoff_unmet_episodes"`
+	IgnoreEpisodesWithFiles      types.Bool   `tfsdk:"ignore_episodes_with_files"`
+	IgnoreEpisodesWithoutFiles   types.Bool   `tfsdk:"ignore_episodes_without_files"`
 }
 
 // Image is part of Series.
@@ -86,7 +141,15 @@ type Image struct {
 	CoverType types.String `tfsdk:"cover_type"`
 	URL       types.String `tfsdk:"url"`
 	RemoteURL types.String `tfsdk:"remote_url"`
-	Extension types.String `tfsdk:"extension"`
+}
+
+func (i Image) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"cover_type": types.StringType,
+			"url":        types.StringType,
+			"remote_url": types.StringType,
+		})
 }
 
 func (r *SeriesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -94,13 +157,18 @@ func (r *SeriesResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	// TODO: waiting to implement seasons and images until empty conversion is managed natively https://www.terraform.io/plugin/framework/accessing-values#conversion-rules
+	// TODO: waiting to implement seasons until empty conversion is managed natively https://www.terraform.io/plugin/framework/accessing-values#conversion-rules
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Series -->\nSeries resource.\nFor more information refer to [Series](https://wiki.servarr.com/sonarr/library#series) documentation.",
 		Attributes: map[string]schema.Attribute{
 			"title": schema.StringAttribute{
-				MarkdownDescription: "Series Title.",
-				Required:            true,
+				MarkdownDescription: "Series Title. Defaults to the TVDB title, a configured value differing only by whitespace or punctuation is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					SeriesTitlePlanModifier{},
+				},
 			},
 			"title_slug": schema.StringAttribute{
 				MarkdownDescription: "Series Title in kebab format.",
@@ -110,6 +178,107 @@ func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				MarkdownDescription: "Monitored flag.",
 				Required:            true,
 			},
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new items. Valid values are 'all' and 'none'.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "none"),
+				},
+			},
+			"add_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Options used only when the series is added to Sonarr. Changes after creation have no effect.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"monitor": schema.StringAttribute{
+						MarkdownDescription: "Episodes to monitor on add. Valid values are 'unknown', 'all', 'future', 'missing', 'existing', 'firstSeason', 'lastSeason', 'latestSeason', 'pilot', 'recent', 'monitorSpecials', 'unmonitorSpecials' and 'none'.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(sonarr.MONITORTYPES_UNKNOWN),
+								string(sonarr.MONITORTYPES_ALL),
+								string(sonarr.MONITORTYPES_FUTURE),
+								string(sonarr.MONITORTYPES_MISSING),
+								string(sonarr.MONITORTYPES_EXISTING),
+								string(sonarr.MONITORTYPES_FIRST_SEASON),
+								string(sonarr.MONITORTYPES_LAST_SEASON),
+								string(sonarr.MONITORTYPES_LATEST_SEASON),
+								string(sonarr.MONITORTYPES_PILOT),
+								string(sonarr.MONITORTYPES_RECENT),
+								string(sonarr.MONITORTYPES_MONITOR_SPECIALS),
+								string(sonarr.MONITORTYPES_UNMONITOR_SPECIALS),
+								string(sonarr.MONITORTYPES_NONE),
+							),
+						},
+					},
+					"search_for_missing_episodes": schema.BoolAttribute{
+						MarkdownDescription: "Search for missing episodes on add. Defaults to `true`.",
+						Optional:            true,
+					},
+					"search_for_cutoff_unmet_episodes": schema.BoolAttribute{
+						MarkdownDescription: "Search for cutoff unmet episodes on add. Defaults to `true`.",
+						Optional:            true,
+					},
+					"ignore_episodes_with_files": schema.BoolAttribute{
+						MarkdownDescription: "Do not monitor episodes with files. Defaults to `false`.",
+						Optional:            true,
+					},
+					"ignore_episodes_without_files": schema.BoolAttribute{
+						MarkdownDescription: "Do not monitor episodes without files. Defaults to `false`.",
+						Optional:            true,
+					},
+				},
+			},
+			"next_airing": schema.StringAttribute{
+				MarkdownDescription: "Next airing date in RFC3339 format, empty if none. It changes over time, do not use it in resource dependencies.",
+				Computed:            true,
+			},
+			"previous_airing": schema.StringAttribute{
+				MarkdownDescription: "Previous airing date in RFC3339 format, empty if none. It changes over time, do not use it in resource dependencies.",
+				Computed:            true,
+			},
+			"folder_name": schema.StringAttribute{
+				MarkdownDescription: "Series folder name assigned by Sonarr.",
+				Computed:            true,
+			},
+			"poster_url": schema.StringAttribute{
+				MarkdownDescription: "Poster remote URL, empty if none.",
+				Computed:            true,
+			},
+			"fanart_url": schema.StringAttribute{
+				MarkdownDescription: "Fanart remote URL, empty if none.",
+				Computed:            true,
+			},
+			"actual_path": schema.StringAttribute{
+				MarkdownDescription: "Current series path in Sonarr. It may differ from `path` when Sonarr renames the series folder.",
+				Computed:            true,
+			},
+			"next_episode": schema.SingleNestedAttribute{
+				MarkdownDescription: "Next monitored episode to air, null if none. Episodes are only looked up when `next_airing` is set, so ended series cost no extra API call. It changes over time, do not use it in resource dependencies.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"season_number": schema.Int64Attribute{
+						MarkdownDescription: "Season number.",
+						Computed:            true,
+					},
+					"episode_number": schema.Int64Attribute{
+						MarkdownDescription: "Episode number.",
+						Computed:            true,
+					},
+					"title": schema.StringAttribute{
+						MarkdownDescription: "Episode title.",
+						Computed:            true,
+					},
+					"air_date": schema.StringAttribute{
+						MarkdownDescription: "Air date in the local time of the network.",
+						Computed:            true,
+					},
+					"air_date_utc": schema.StringAttribute{
+						MarkdownDescription: "Air date in RFC3339 format.",
+						Computed:            true,
+					},
+				},
+			},
 			"season_folder": schema.BoolAttribute{
 				MarkdownDescription: "Season Folder flag.",
 				Required:            true,
@@ -122,17 +291,33 @@ func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				MarkdownDescription: "Quality Profile ID.",
 				Required:            true,
 			},
+			"language_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Language Profile ID. Required on Sonarr v3, ignored on v4.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"tvdb_id": schema.Int64Attribute{
 				MarkdownDescription: "TVDB ID.",
 				Required:            true,
 			},
 			"path": schema.StringAttribute{
-				MarkdownDescription: "Series Path.",
-				Required:            true,
+				MarkdownDescription: "Series Path. Defaults to the series folder in `root_folder_path`. Use `ignore_changes = [path]` to keep the folder when Sonarr renames it.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"root_folder_path": schema.StringAttribute{
-				MarkdownDescription: "Series Root Folder.",
-				Required:            true,
+				MarkdownDescription: "Series Root Folder. Must match one of the configured root folders. Required if `path` is not set, otherwise defaults to the root folder containing `path`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("path")),
+				},
 			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
@@ -147,6 +332,32 @@ func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"images": schema.SetNestedAttribute{
+				MarkdownDescription: "Series images.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.getImageSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (r SeriesResource) getImageSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cover_type": schema.StringAttribute{
+				MarkdownDescription: "Cover type, such as 'poster', 'fanart' or 'banner'.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Image URL on the Sonarr server.",
+				Computed:            true,
+			},
+			"remote_url": schema.StringAttribute{
+				MarkdownDescription: "Image remote URL.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -158,9 +369,15 @@ func (r *SeriesResource) Configure(ctx context.Context, req resource.ConfigureRe
 	}
 }
 
+func (r *SeriesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		SeriesRootFolderValidator{resource: r},
+	}
+}
+
 func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var series *Series
+	var series *SeriesWithAddOptions
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &series)...)
 
@@ -170,14 +387,12 @@ func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Create new Series
 	request := series.read(ctx, &resp.Diagnostics)
-	// TODO: can parametrize AddSeriesOptions
-	options := sonarr.NewAddSeriesOptions()
-	options.SetSearchForMissingEpisodes(true)
-	options.SetSearchForCutoffUnmetEpisodes(true)
-	options.SetIgnoreEpisodesWithFiles(false)
-	options.SetIgnoreEpisodesWithoutFiles(false)
+	request.SetAddOptions(*series.readAddOptions(ctx, &resp.Diagnostics))
 
-	request.SetAddOptions(*options)
+	// Sonarr requires a title, but replaces it with the TVDB one when adding the series
+	if series.Title.IsUnknown() {
+		request.SetTitle(series.TitleSlug.ValueString())
+	}
 
 	response, _, err := r.client.SeriesAPI.CreateSeries(r.auth).SeriesResource(*request).Execute()
 	if err != nil {
@@ -189,12 +404,14 @@ func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest,
 	tflog.Trace(ctx, "created "+seriesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	series.write(ctx, response, &resp.Diagnostics)
+	r.writeRootFolder(&series.Series, &resp.Diagnostics)
+	r.writeNextEpisode(ctx, series, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
 }
 
 func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var series *Series
+	var series *SeriesWithAddOptions
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &series)...)
 
@@ -211,14 +428,26 @@ func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	tflog.Trace(ctx, "read "+seriesResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	// Read cannot tell a configured path from a computed one, the warning covers both
+	if !series.Path.IsNull() && series.Path.ValueString() != response.GetPath() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("path"),
+			helpers.ResourceWarning,
+			fmt.Sprintf("Series path changed outside Terraform from '%s' to '%s'. When `path` is configured, the next apply moves the series back unless the configuration is updated or `ignore_changes = [path]` is set.", series.Path.ValueString(), response.GetPath()),
+		)
+	}
+
 	// Map response body to resource schema attribute
 	series.write(ctx, response, &resp.Diagnostics)
+	r.writeRootFolder(&series.Series, &resp.Diagnostics)
+	r.writeNextEpisode(ctx, series, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
 }
 
 func (r *SeriesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var series *Series
+	var series *SeriesWithAddOptions
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &series)...)
 
@@ -240,6 +469,8 @@ func (r *SeriesResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Trace(ctx, "updated "+seriesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	series.write(ctx, response, &resp.Diagnostics)
+	r.writeRootFolder(&series.Series, &resp.Diagnostics)
+	r.writeNextEpisode(ctx, series, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
 }
 
@@ -282,9 +513,123 @@ func (s *Series) write(ctx context.Context, series *sonarr.SeriesResource, diags
 	s.Path = types.StringValue(series.GetPath())
 	s.Title = types.StringValue(series.GetTitle())
 	s.TitleSlug = types.StringValue(series.GetTitleSlug())
-	s.RootFolderPath = types.StringValue(series.GetRootFolderPath())
+	s.MonitorNewItems = types.StringValue(string(series.GetMonitorNewItems()))
+	s.FolderName = types.StringValue(series.GetFolder())
+	s.NextAiring = types.StringValue("")
+	s.PreviousAiring = types.StringValue("")
+
+	// Keep the configured root folder when it differs only by the trailing separator
+	if s.RootFolderPath.IsNull() || s.RootFolderPath.IsUnknown() || trimPathSeparator(s.RootFolderPath.ValueString()) != trimPathSeparator(series.GetRootFolderPath()) {
+		s.RootFolderPath = types.StringValue(series.GetRootFolderPath())
+	}
+
+	if !series.GetNextAiring().IsZero() {
+		s.NextAiring = types.StringValue(series.GetNextAiring().Format(time.RFC3339))
+	}
+
+	if !series.GetPreviousAiring().IsZero() {
+		s.PreviousAiring = types.StringValue(series.GetPreviousAiring().Format(time.RFC3339))
+	}
+
+	// Fallback to the last path element when folder is not returned
+	if series.GetFolder() == "" {
+		folder := trimPathSeparator(series.GetPath())
+		s.FolderName = types.StringValue(folder[strings.LastIndexAny(folder, "/\\")+1:])
+	}
+
+	// Sonarr v4 has no language profiles, keep the configured value in that case.
+	if series.GetLanguageProfileId() != 0 || s.LanguageProfileID.IsNull() || s.LanguageProfileID.IsUnknown() {
+		s.LanguageProfileID = types.Int64Value(int64(series.GetLanguageProfileId()))
+	}
+
 	s.Tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, series.GetTags())
 	diags.Append(tempDiag...)
+
+	s.PosterURL = types.StringValue("")
+	s.FanartURL = types.StringValue("")
+	images := make([]Image, len(series.GetImages()))
+
+	for i, image := range series.GetImages() {
+		images[i].write(&image)
+
+		switch image.GetCoverType() {
+		case sonarr.MEDIACOVERTYPES_POSTER:
+			s.PosterURL = types.StringValue(image.GetRemoteUrl())
+		case sonarr.MEDIACOVERTYPES_FANART:
+			s.FanartURL = types.StringValue(image.GetRemoteUrl())
+		}
+	}
+
+	s.Images, tempDiag = types.SetValueFrom(ctx, Image{}.getType(), images)
+	diags.Append(tempDiag...)
+}
+
+func (i *Image) write(image *sonarr.MediaCover) {
+	i.CoverType = types.StringValue(string(image.GetCoverType()))
+	i.URL = types.StringValue(image.GetUrl())
+	i.RemoteURL = types.StringValue(image.GetRemoteUrl())
+}
+
+func (s *SeriesWithAddOptions) write(ctx context.Context, series *sonarr.SeriesResource, diags *diag.Diagnostics) {
+	title := s.Title
+
+	s.Series.write(ctx, series, diags)
+	s.ActualPath = types.StringValue(series.GetPath())
+
+	// Keep the planned title when it matches the TVDB one except for whitespace and punctuation.
+	if !title.IsNull() && !title.IsUnknown() && normalizeSeriesTitle(title.ValueString()) == normalizeSeriesTitle(series.GetTitle()) {
+		s.Title = title
+	}
+}
+
+// normalizeSeriesTitle strips whitespace and punctuation from a title.
+func normalizeSeriesTitle(title string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) {
+			return -1
+		}
+
+		return r
+	}, title)
+}
+
+// readAddOptions returns the creation options, overriding the defaults with the configured ones.
+func (s *SeriesWithAddOptions) readAddOptions(ctx context.Context, diags *diag.Diagnostics) *sonarr.AddSeriesOptions {
+	options := sonarr.NewAddSeriesOptions()
+	options.SetSearchForMissingEpisodes(true)
+	options.SetSearchForCutoffUnmetEpisodes(true)
+	options.SetIgnoreEpisodesWithFiles(false)
+	options.SetIgnoreEpisodesWithoutFiles(false)
+
+	if s.AddOptions.IsNull() || s.AddOptions.IsUnknown() {
+		return options
+	}
+
+	var addOptions AddSeriesOptions
+
+	diags.Append(s.AddOptions.As(ctx, &addOptions, basetypes.ObjectAsOptions{})...)
+
+	if !addOptions.Monitor.IsNull() {
+		options.SetMonitor(sonarr.MonitorTypes(addOptions.Monitor.ValueString()))
+	}
+
+	if !addOptions.SearchForMissingEpisodes.IsNull() {
+		options.SetSearchForMissingEpisodes(addOptions.SearchForMissingEpisodes.ValueBool())
+	}
+
+	if !addOptions.SearchForCutoffUnmetEpisodes.IsNull() {
+		options.SetSearchForCutoffUnmetEpisodes(addOptions.SearchForCutoffUnmetEpisodes.ValueBool())
+	}
+
+	if !addOptions.IgnoreEpisodesWithFiles.IsNull() {
+		options.SetIgnoreEpisodesWithFiles(addOptions.IgnoreEpisodesWithFiles.ValueBool())
+	}
+
+	if !addOptions.IgnoreEpisodesWithoutFiles.IsNull() {
+		options.SetIgnoreEpisodesWithoutFiles(addOptions.IgnoreEpisodesWithoutFiles.ValueBool())
+	}
+
+	return options
 }
 
 func (s *Series) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.SeriesResource {
@@ -296,10 +641,182 @@ func (s *Series) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.Seri
 	series.SetQualityProfileId(int32(s.QualityProfileID.ValueInt64()))
 	series.SetMonitored(s.Monitored.ValueBool())
 	series.SetSeasonFolder(s.SeasonFolder.ValueBool())
-	series.SetPath(s.Path.ValueString())
-	series.SetRootFolderPath(s.Path.ValueString())
 	series.SetUseSceneNumbering(s.UseSceneNumbering.ValueBool())
 	diags.Append(s.Tags.ElementsAs(ctx, &series.Tags, true)...)
 
+	if !s.Path.IsNull() && !s.Path.IsUnknown() {
+		series.SetPath(s.Path.ValueString())
+	}
+
+	if !s.RootFolderPath.IsNull() && !s.RootFolderPath.IsUnknown() {
+		series.SetRootFolderPath(s.RootFolderPath.ValueString())
+	}
+
+	if !s.MonitorNewItems.IsNull() && !s.MonitorNewItems.IsUnknown() {
+		series.SetMonitorNewItems(sonarr.NewItemMonitorTypes(s.MonitorNewItems.ValueString()))
+	}
+
+	if !s.LanguageProfileID.IsNull() && !s.LanguageProfileID.IsUnknown() {
+		series.SetLanguageProfileId(int32(s.LanguageProfileID.ValueInt64()))
+	}
+
 	return series
 }
+
+// writeRootFolder sets the root folder from the series path when Sonarr does not return it.
+func (r *SeriesResource) writeRootFolder(series *Series, diags *diag.Diagnostics) {
+	if series.RootFolderPath.ValueString() != "" {
+		return
+	}
+
+	folders, _, err := r.client.RootFolderAPI.ListRootFolder(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFoldersDataSourceName, err))
+
+		return
+	}
+
+	series.RootFolderPath = types.StringValue(findRootFolder(folders, series.Path.ValueString()))
+}
+
+// writeNextEpisode sets the next monitored episode to air.
+// The episodes are listed only when Sonarr reports a next airing date, trading completeness for fewer API calls.
+func (r *SeriesResource) writeNextEpisode(ctx context.Context, series *SeriesWithAddOptions, diags *diag.Diagnostics) {
+	var (
+		next     *sonarr.EpisodeResource
+		tempDiag diag.Diagnostics
+	)
+
+	series.NextEpisode = types.ObjectNull(NextEpisode{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes())
+
+	if series.NextAiring.ValueString() == "" {
+		return
+	}
+
+	episodes, _, err := r.client.EpisodeAPI.ListEpisode(r.auth).SeriesId(int32(series.ID.ValueInt64())).IncludeImages(false).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesResourceName, err))
+
+		return
+	}
+
+	now := time.Now()
+
+	for i, e := range episodes {
+		if !e.GetMonitored() || !e.GetAirDateUtc().After(now) {
+			continue
+		}
+
+		if next == nil || e.GetAirDateUtc().Before(next.GetAirDateUtc()) {
+			next = &episodes[i]
+		}
+	}
+
+	if next == nil {
+		return
+	}
+
+	episode := NextEpisode{
+		Title:         types.StringValue(next.GetTitle()),
+		AirDate:       types.StringValue(next.GetAirDate()),
+		AirDateUtc:    types.StringValue(next.GetAirDateUtc().Format(time.RFC3339)),
+		SeasonNumber:  types.Int64Value(int64(next.GetSeasonNumber())),
+		EpisodeNumber: types.Int64Value(int64(next.GetEpisodeNumber())),
+	}
+	series.NextEpisode, tempDiag = types.ObjectValueFrom(ctx, episode.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), episode)
+	diags.Append(tempDiag...)
+}
+
+// findRootFolder returns the root folder path that is the longest prefix of the series path.
+func findRootFolder(folders []sonarr.RootFolderResource, seriesPath string) string {
+	root := ""
+
+	for _, f := range folders {
+		folder := trimPathSeparator(f.GetPath())
+		if strings.HasPrefix(seriesPath, folder+"/") || strings.HasPrefix(seriesPath, folder+"\\") {
+			if len(f.GetPath()) > len(root) {
+				root = f.GetPath()
+			}
+		}
+	}
+
+	return root
+}
+
+// trimPathSeparator removes the trailing separators, so that Unix and Windows folders compare equal with or without them.
+func trimPathSeparator(path string) string {
+	return strings.TrimRight(path, "/\\")
+}
+
+// SeriesRootFolderValidator checks the root folder path is one of the configured root folders.
+type SeriesRootFolderValidator struct {
+	resource *SeriesResource
+}
+
+func (v SeriesRootFolderValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v SeriesRootFolderValidator) MarkdownDescription(_ context.Context) string {
+	return "Root folder path must match one of the configured root folders."
+}
+
+func (v SeriesRootFolderValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Root folders can be checked only when the provider is configured
+	if v.resource == nil || v.resource.client == nil {
+		return
+	}
+
+	var rootFolderPath types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("root_folder_path"), &rootFolderPath)...)
+
+	if resp.Diagnostics.HasError() || rootFolderPath.IsNull() || rootFolderPath.IsUnknown() {
+		return
+	}
+
+	folders, _, err := v.resource.client.RootFolderAPI.ListRootFolder(v.resource.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, rootFolderResourceName, err))
+
+		return
+	}
+
+	paths := make([]string, len(folders))
+
+	for i, f := range folders {
+		if trimPathSeparator(f.GetPath()) == trimPathSeparator(rootFolderPath.ValueString()) {
+			return
+		}
+
+		paths[i] = f.GetPath()
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("root_folder_path"),
+		helpers.ResourceError,
+		fmt.Sprintf("Root folder '%s' is not configured, must be one of %v", rootFolderPath.ValueString(), paths),
+	)
+}
+
+// SeriesTitlePlanModifier keeps the state title when the configured one differs only by whitespace or punctuation.
+type SeriesTitlePlanModifier struct{}
+
+func (m SeriesTitlePlanModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m SeriesTitlePlanModifier) MarkdownDescription(_ context.Context) string {
+	return "A configured title differing from the Sonarr one only by whitespace or punctuation is not changed."
+}
+
+func (m SeriesTitlePlanModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to compare on create or when the title is not configured
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	if normalizeSeriesTitle(req.ConfigValue.ValueString()) == normalizeSeriesTitle(req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
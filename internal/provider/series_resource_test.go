@@ -20,11 +20,26 @@ func TestAccSeriesResource(t *testing.T) {
 				Config:      testAccSeriesResourceConfig(81189, "Breaking Bad", "breaking-bad", "false") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Invalid root folder
+			{
+				PreConfig:   rootFolderDSInit,
+				Config:      testAccSeriesResourceInvalidRootFolderConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not configured"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccSeriesResourceConfig(81189, "Breaking Bad", "breaking-bad", "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_series.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "monitor_new_items", "all"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "next_airing", ""),
+					resource.TestCheckNoResourceAttr("sonarr_series.test", "next_episode"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "folder_name", "breaking-bad"),
+					resource.TestCheckResourceAttrSet("sonarr_series.test", "images.#"),
+					resource.TestCheckResourceAttrSet("sonarr_series.test", "poster_url"),
+					resource.TestCheckResourceAttrPair("sonarr_series.test", "actual_path", "sonarr_series.test", "path"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "root_folder_path", "/config"),
 					resource.TestCheckResourceAttrSet("sonarr_series.test", "id"),
 				),
 			},
@@ -38,6 +53,7 @@ func TestAccSeriesResource(t *testing.T) {
 				Config: testAccSeriesResourceConfig(81189, "Breaking Bad", "breaking-bad", "true"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_series.test", "monitored", "true"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "monitor_new_items", "all"),
 				),
 			},
 			// ImportState testing
@@ -59,12 +75,126 @@ func testAccSeriesResourceConfig(id int, title, slug, monitored string) string {
 		tvdb_id    = %d
 	  
 		monitored           = %s
+		monitor_new_items   = "all"
 		season_folder       = true
 		use_scene_numbering = false
 		path                = "/config/%s"
-		root_folder_path    = "/config"
 	  
 		quality_profile_id  = 1
 	}
 	`, title, slug, id, monitored, slug)
 }
+
+const testAccSeriesResourceInvalidRootFolderConfig = `
+	resource "sonarr_series" "test" {
+		title      = "Breaking Bad"
+		title_slug = "breaking-bad"
+		tvdb_id    = 81189
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/wrong/breaking-bad"
+		root_folder_path    = "/wrong"
+
+		quality_profile_id  = 1
+	}
+`
+
+func TestAccSeriesResource_languageProfile(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckVersion(t, "3") },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSeriesResourceLanguageProfileConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sonarr_series.test", "language_profile_id", "data.sonarr_language_profile.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccSeriesResourceLanguageProfileConfig = `
+	data "sonarr_language_profile" "test" {
+		name = "English"
+	}
+
+	resource "sonarr_series" "test" {
+		title      = "The Wire"
+		title_slug = "the-wire"
+		tvdb_id    = 79126
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/the-wire"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+		language_profile_id = data.sonarr_language_profile.test.id
+	}
+`
+
+func TestAccSeriesResource_addOptions(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid monitor option
+			{
+				Config:      testAccSeriesResourceAddOptionsConfig("first"),
+				ExpectError: regexp.MustCompile("value must be one of"),
+				PlanOnly:    true,
+			},
+			// Create and Read testing
+			{
+				Config: testAccSeriesResourceAddOptionsConfig("latestSeason"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.test", "add_options.monitor", "latestSeason"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "add_options.search_for_missing_episodes", "false"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "title", "The Sopranos"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "path", "/config/The Sopranos"),
+					resource.TestCheckResourceAttrSet("sonarr_series.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sonarr_series.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"add_options"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSeriesResourceAddOptionsConfig(monitor string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "test" {
+		title_slug = "the-sopranos"
+		tvdb_id    = 75299
+
+		monitored           = true
+		season_folder       = true
+		use_scene_numbering = false
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+
+		add_options = {
+			monitor                          = "%s"
+			search_for_missing_episodes      = false
+			search_for_cutoff_unmet_episodes = false
+		}
+	}
+	`, monitor)
+}
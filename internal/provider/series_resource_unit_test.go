@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnitSeries_writeRootFolderPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		configured types.String
+		api        string
+		expected   string
+	}{
+		"trailing_slash": {
+			configured: types.StringValue("/data/tv/"),
+			api:        "/data/tv",
+			expected:   "/data/tv/",
+		},
+		"trailing_backslash": {
+			configured: types.StringValue("D:\\tv\\"),
+			api:        "D:\\tv",
+			expected:   "D:\\tv\\",
+		},
+		"different": {
+			configured: types.StringValue("/data/tv/"),
+			api:        "/data/anime",
+			expected:   "/data/anime",
+		},
+		"unknown": {
+			configured: types.StringUnknown(),
+			api:        "/data/tv/",
+			expected:   "/data/tv/",
+		},
+		"null": {
+			configured: types.StringNull(),
+			api:        "/data/tv",
+			expected:   "/data/tv",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			response := sonarr.NewSeriesResource()
+			response.SetRootFolderPath(test.api)
+
+			series := Series{RootFolderPath: test.configured}
+			series.write(context.Background(), response, &diags)
+
+			if series.RootFolderPath.ValueString() != test.expected {
+				t.Errorf("expected root_folder_path %s, got %s", test.expected, series.RootFolderPath)
+			}
+
+			if diags.HasError() {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestUnitSeries_titlePlanModifier(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config   types.String
+		plan     types.String
+		state    types.String
+		expected types.String
+	}{
+		"punctuation": {
+			config:   types.StringValue("The Office (US)"),
+			plan:     types.StringValue("The Office (US)"),
+			state:    types.StringValue("The Office US"),
+			expected: types.StringValue("The Office US"),
+		},
+		"different": {
+			config:   types.StringValue("The Office"),
+			plan:     types.StringValue("The Office"),
+			state:    types.StringValue("The Office US"),
+			expected: types.StringValue("The Office"),
+		},
+		"create": {
+			config:   types.StringValue("The Office (US)"),
+			plan:     types.StringValue("The Office (US)"),
+			state:    types.StringNull(),
+			expected: types.StringValue("The Office (US)"),
+		},
+		"not_configured": {
+			config:   types.StringNull(),
+			plan:     types.StringUnknown(),
+			state:    types.StringNull(),
+			expected: types.StringUnknown(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				ConfigValue: test.config,
+				PlanValue:   test.plan,
+				StateValue:  test.state,
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: test.plan,
+			}
+
+			SeriesTitlePlanModifier{}.PlanModifyString(context.Background(), req, resp)
+
+			if !resp.PlanValue.Equal(test.expected) {
+				t.Errorf("expected title %s, got %s", test.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestUnitSeries_rootFolderValidator(t *testing.T) {
+	t.Parallel()
+
+	serverURL, key, cleanup := testutil.MockSonarrServer(t)
+	t.Cleanup(cleanup)
+
+	auth, client := testUnitAPIClient(t, serverURL, key)
+	r := &SeriesResource{client: client, auth: auth}
+
+	tests := map[string]struct {
+		path  string
+		valid bool
+	}{
+		"exact": {
+			path:  "/config/",
+			valid: true,
+		},
+		"no_trailing_slash": {
+			path:  "/config",
+			valid: true,
+		},
+		"trailing_backslash": {
+			path:  "D:\\tv\\",
+			valid: true,
+		},
+		"missing": {
+			path:  "/missing",
+			valid: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			if diags := plan.SetAttribute(ctx, path.Root("root_folder_path"), test.path); diags.HasError() {
+				t.Fatalf("unable to build the configuration: %v", diags)
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			SeriesRootFolderValidator{resource: r}.ValidateResource(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+
+			if resp.Diagnostics.HasError() == test.valid {
+				t.Errorf("expected valid %t for %s, got %v", test.valid, test.path, resp.Diagnostics)
+			}
+		})
+	}
+}
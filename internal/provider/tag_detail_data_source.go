@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const tagDetailDataSourceName = "tag_detail"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagDetailsDataSource{}
+
+func NewTagDetailsDataSource() datasource.DataSource {
+	return &TagDetailsDataSource{}
+}
+
+// TagDetailsDataSource defines the tag detail implementation.
+type TagDetailsDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// TagDetail describes the tag detail data model.
+type TagDetail struct {
+	SeriesIDs         types.Set    `tfsdk:"series_ids"`
+	IndexerIDs        types.Set    `tfsdk:"indexer_ids"`
+	DownloadClientIDs types.Set    `tfsdk:"download_client_ids"`
+	NotificationIDs   types.Set    `tfsdk:"notification_ids"`
+	ImportListIDs     types.Set    `tfsdk:"import_list_ids"`
+	DelayProfileIDs   types.Set    `tfsdk:"delay_profile_ids"`
+	RestrictionIDs    types.Set    `tfsdk:"restriction_ids"`
+	AutoTagIDs        types.Set    `tfsdk:"auto_tag_ids"`
+	Label             types.String `tfsdk:"label"`
+	ID                types.Int64  `tfsdk:"id"`
+}
+
+func (t TagDetail) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"id":                  types.Int64Type,
+			"label":               types.StringType,
+			"series_ids":          types.SetType{}.WithElementType(types.Int64Type),
+			"indexer_ids":         types.SetType{}.WithElementType(types.Int64Type),
+			"download_client_ids": types.SetType{}.WithElementType(types.Int64Type),
+			"notification_ids":    types.SetType{}.WithElementType(types.Int64Type),
+			"import_list_ids":     types.SetType{}.WithElementType(types.Int64Type),
+			"delay_profile_ids":   types.SetType{}.WithElementType(types.Int64Type),
+			"restriction_ids":     types.SetType{}.WithElementType(types.Int64Type),
+			"auto_tag_ids":        types.SetType{}.WithElementType(types.Int64Type),
+		})
+}
+
+func (d *TagDetailsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + tagDetailDataSourceName
+}
+
+func (d *TagDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Tags -->\nSingle [Tag](../resources/tag) with the list of resources using it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Tag ID.",
+				Required:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Tag label.",
+				Computed:            true,
+			},
+			"series_ids": schema.SetAttribute{
+				MarkdownDescription: "Series IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"indexer_ids": schema.SetAttribute{
+				MarkdownDescription: "Indexer IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"download_client_ids": schema.SetAttribute{
+				MarkdownDescription: "Download client IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"notification_ids": schema.SetAttribute{
+				MarkdownDescription: "Notification IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"import_list_ids": schema.SetAttribute{
+				MarkdownDescription: "Import list IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"delay_profile_ids": schema.SetAttribute{
+				MarkdownDescription: "Delay profile IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"restriction_ids": schema.SetAttribute{
+				MarkdownDescription: "Release profile IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"auto_tag_ids": schema.SetAttribute{
+				MarkdownDescription: "Auto tag IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (d *TagDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *TagDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *TagDetail
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get tag details current value
+	response, httpResp, err := d.client.TagDetailsAPI.GetTagDetailById(d.auth, int32(data.ID.ValueInt64())).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(tagDetailDataSourceName, "id", strconv.Itoa(int(data.ID.ValueInt64()))))
+
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagDetailDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+tagDetailDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (t *TagDetail) write(ctx context.Context, tag *sonarr.TagDetailsResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	t.ID = types.Int64Value(int64(tag.GetId()))
+	t.Label = types.StringValue(tag.GetLabel())
+	t.SeriesIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetSeriesIds())
+	diags.Append(tempDiag...)
+	t.IndexerIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetIndexerIds())
+	diags.Append(tempDiag...)
+	t.DownloadClientIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetDownloadClientIds())
+	diags.Append(tempDiag...)
+	t.NotificationIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetNotificationIds())
+	diags.Append(tempDiag...)
+	t.ImportListIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetImportListIds())
+	diags.Append(tempDiag...)
+	t.DelayProfileIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetDelayProfileIds())
+	diags.Append(tempDiag...)
+	t.RestrictionIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetRestrictionIds())
+	diags.Append(tempDiag...)
+	t.AutoTagIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tag.GetAutoTagIds())
+	diags.Append(tempDiag...)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagDetailDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccTagDetailDataSourceConfig("999") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccTagDetailDataSourceConfig("999"),
+				ExpectError: regexp.MustCompile("Unable to find tag_detail"),
+			},
+			// Create a resource be read
+			{
+				Config: testAccTagResourceConfig("test", "tag_detail_datasource"),
+			},
+			// Read testing
+			{
+				Config: testAccTagResourceConfig("test", "tag_detail_datasource") + testAccTagDetailDataSourceConfig("sonarr_tag.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sonarr_tag_detail.test", "id", "sonarr_tag.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_tag_detail.test", "label", "tag_detail_datasource"),
+					resource.TestCheckResourceAttr("data.sonarr_tag_detail.test", "series_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagDetailDataSourceConfig(id string) string {
+	return fmt.Sprintf(`
+	data "sonarr_tag_detail" "test" {
+		id = %s
+	}
+	`, id)
+}
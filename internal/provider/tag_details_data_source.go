@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const tagDetailsDataSourceName = "tag_details"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AllTagDetailsDataSource{}
+
+func NewAllTagDetailsDataSource() datasource.DataSource {
+	return &AllTagDetailsDataSource{}
+}
+
+// AllTagDetailsDataSource defines the tag details implementation.
+type AllTagDetailsDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// TagDetailsList describes the tag details data model.
+type TagDetailsList struct {
+	TagDetails types.Set    `tfsdk:"tag_details"`
+	ID         types.String `tfsdk:"id"`
+}
+
+func (d *AllTagDetailsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + tagDetailsDataSourceName
+}
+
+func (d *AllTagDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Tags -->\nList all available [Tags](../resources/tag) with the list of resources using them.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tag_details": schema.SetNestedAttribute{
+				MarkdownDescription: "Tag detail list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Tag ID.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Tag label.",
+							Computed:            true,
+						},
+						"series_ids": schema.SetAttribute{
+							MarkdownDescription: "Series IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"indexer_ids": schema.SetAttribute{
+							MarkdownDescription: "Indexer IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"download_client_ids": schema.SetAttribute{
+							MarkdownDescription: "Download client IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"notification_ids": schema.SetAttribute{
+							MarkdownDescription: "Notification IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"import_list_ids": schema.SetAttribute{
+							MarkdownDescription: "Import list IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"delay_profile_ids": schema.SetAttribute{
+							MarkdownDescription: "Delay profile IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"restriction_ids": schema.SetAttribute{
+							MarkdownDescription: "Release profile IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"auto_tag_ids": schema.SetAttribute{
+							MarkdownDescription: "Auto tag IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AllTagDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *AllTagDetailsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get tag details current value
+	response, _, err := d.client.TagDetailsAPI.ListTagDetail(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagDetailsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+tagDetailsDataSourceName)
+	// Map response body to resource schema attribute
+	details := make([]TagDetail, len(response))
+	for i, t := range response {
+		details[i].write(ctx, &t, &resp.Diagnostics)
+	}
+
+	detailList, diags := types.SetValueFrom(ctx, TagDetail{}.getType(), details)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, TagDetailsList{TagDetails: detailList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
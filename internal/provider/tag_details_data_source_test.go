@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagDetailsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccTagDetailsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create a resource to have a value to check
+			{
+				Config: testAccTagResourceConfig("test", "tag_details"),
+			},
+			// Read testing
+			{
+				Config: testAccTagResourceConfig("test", "tag_details") + testAccTagDetailsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.sonarr_tag_details.test", "tag_details.#", func(value string) error {
+						if value == "0" {
+							return fmt.Errorf("expected at least one tag detail")
+						}
+
+						return nil
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_tag_details.test", "tag_details.*", map[string]string{"label": "tag_details"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccTagDetailsDataSourceConfig = `
+data "sonarr_tag_details" "test" {
+}
+`
@@ -189,7 +189,16 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), tagResourceName, req, resp, func() (map[string]int, error) {
+		tags, _, err := r.client.TagAPI.ListTag(r.auth).Execute()
+		names := make(map[string]int, len(tags))
+
+		for _, t := range tags {
+			names[t.GetLabel()] = int(t.GetId())
+		}
+
+		return names, err
+	})
 	tflog.Trace(ctx, "imported "+tagResourceName+": "+req.ID)
 }
 
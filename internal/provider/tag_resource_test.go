@@ -47,6 +47,13 @@ func TestAccTagResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by label testing
+			{
+				ResourceName:      "sonarr_tag.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFromAttribute("sonarr_tag.test", "label"),
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const tagsSetResourceName = "tags_set"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &TagsSetResource{}
+	_ resource.ResourceWithImportState = &TagsSetResource{}
+)
+
+func NewTagsSetResource() resource.Resource {
+	return &TagsSetResource{}
+}
+
+// TagsSetResource defines the tags set implementation.
+type TagsSetResource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// TagsSet describes the tags set data model.
+type TagsSet struct {
+	Labels          types.Set   `tfsdk:"labels"`
+	CreatedLabels   types.Set   `tfsdk:"created_labels"`
+	TagIDs          types.Map   `tfsdk:"tag_ids"`
+	ID              types.Int64 `tfsdk:"id"`
+	DeleteUnmanaged types.Bool  `tfsdk:"delete_unmanaged"`
+}
+
+func (r *TagsSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + tagsSetResourceName
+}
+
+func (r *TagsSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Tags -->\nTags set resource, to manage several [Tags](tag) as a unit.\nMissing labels are created and existing ones are adopted. Only the created labels are deleted, when removed from the set or on destroy, unless `delete_unmanaged` is set.\nFor more information refer to [Tags](https://wiki.servarr.com/sonarr/settings#tags) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.SetAttribute{
+				MarkdownDescription: "Tag labels. They must be lowercase.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^.*[^A-Z]+.*$`),
+							"String cannot contains uppercase values",
+						),
+					),
+				},
+			},
+			"delete_unmanaged": schema.BoolAttribute{
+				MarkdownDescription: "Delete the tags not in `labels`, and all the tags of the set on destroy, including the adopted ones. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"created_labels": schema.SetAttribute{
+				MarkdownDescription: "Labels created by this resource, the other ones were adopted.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"tag_ids": schema.MapAttribute{
+				MarkdownDescription: "Tag IDs by label.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Tags set ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TagsSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *TagsSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var tags *TagsSet
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create missing tags
+	r.reconcile(ctx, tags, nil, nil, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+tagsSetResourceName+": 1")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tags)...)
+}
+
+func (r *TagsSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var tags *TagsSet
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get tags current value
+	existing := r.list(&resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+tagsSetResourceName+": 1")
+	// Map response body to resource schema attribute
+	tags.write(ctx, existing, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tags)...)
+}
+
+func (r *TagsSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var tags, state *TagsSet
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &tags)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create missing tags and delete the removed ones
+	removed := make([]string, 0)
+	created := make([]string, 0)
+	resp.Diagnostics.Append(state.Labels.ElementsAs(ctx, &removed, false)...)
+	resp.Diagnostics.Append(state.CreatedLabels.ElementsAs(ctx, &created, false)...)
+	r.reconcile(ctx, tags, removed, created, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+tagsSetResourceName+": 1")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tags)...)
+}
+
+func (r *TagsSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var tags *TagsSet
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagIDs := make(map[string]int64)
+	created := make([]string, 0)
+	resp.Diagnostics.Append(tags.TagIDs.ElementsAs(ctx, &tagIDs, false)...)
+	resp.Diagnostics.Append(tags.CreatedLabels.ElementsAs(ctx, &created, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the created tags, adopted ones are left alone
+	for label, id := range tagIDs {
+		if !tags.DeleteUnmanaged.ValueBool() && !slices.Contains(created, label) {
+			continue
+		}
+
+		if _, err := r.client.TagAPI.DeleteTag(r.auth, int32(id)).Execute(); err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, tagsSetResourceName, err))
+
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted "+tagsSetResourceName+": 1")
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *TagsSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	labels := make([]string, 0)
+
+	for _, label := range strings.Split(req.ID, ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+
+	if len(labels) == 0 {
+		resp.Diagnostics.AddError(
+			helpers.UnexpectedImportIdentifier,
+			fmt.Sprintf("Expected import identifier with format: label1,label2. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	// Imported labels are adopted, they are not deleted on destroy
+	tflog.Trace(ctx, "imported "+tagsSetResourceName+": "+req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), 1)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("labels"), labels)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("created_labels"), []string{})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_unmanaged"), false)...)
+}
+
+// list returns the existing tag IDs by label.
+func (r *TagsSetResource) list(diags *diag.Diagnostics) map[string]int32 {
+	response, _, err := r.client.TagAPI.ListTag(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagsSetResourceName, err))
+
+		return nil
+	}
+
+	existing := make(map[string]int32, len(response))
+	for _, t := range response {
+		existing[t.GetLabel()] = t.GetId()
+	}
+
+	return existing
+}
+
+// reconcile creates the missing labels and deletes the removed ones it created, or all the unmanaged ones if requested.
+func (r *TagsSetResource) reconcile(ctx context.Context, tags *TagsSet, removed, created []string, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	existing := r.list(diags)
+	labels := make([]string, 0)
+	diags.Append(tags.Labels.ElementsAs(ctx, &labels, false)...)
+
+	if diags.HasError() {
+		return
+	}
+
+	for _, label := range labels {
+		if _, ok := existing[label]; ok {
+			continue
+		}
+
+		request := sonarr.NewTagResource()
+		request.SetLabel(label)
+
+		response, _, err := r.client.TagAPI.CreateTag(r.auth).TagResource(*request).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagsSetResourceName, err))
+
+			return
+		}
+
+		tflog.Trace(ctx, "created "+tagResourceName+": "+strconv.Itoa(int(response.GetId())))
+		existing[response.GetLabel()] = response.GetId()
+		created = append(created, response.GetLabel())
+	}
+
+	for label, id := range existing {
+		if slices.Contains(labels, label) || (!tags.DeleteUnmanaged.ValueBool() && !(slices.Contains(removed, label) && slices.Contains(created, label))) {
+			continue
+		}
+
+		if _, err := r.client.TagAPI.DeleteTag(r.auth, id).Execute(); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, tagsSetResourceName, err))
+
+			return
+		}
+
+		tflog.Trace(ctx, "deleted "+tagResourceName+": "+strconv.Itoa(int(id)))
+		delete(existing, label)
+	}
+
+	tags.ID = types.Int64Value(1)
+	tags.CreatedLabels, tempDiag = types.SetValueFrom(ctx, types.StringType, created)
+	diags.Append(tempDiag...)
+	tags.write(ctx, existing, diags)
+}
+
+// write keeps the managed labels that still exist, or all of them when unmanaged ones must be deleted to plan their removal.
+func (t *TagsSet) write(ctx context.Context, existing map[string]int32, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	labels := make([]string, 0)
+	diags.Append(t.Labels.ElementsAs(ctx, &labels, false)...)
+
+	tagIDs := make(map[string]int64, len(labels))
+
+	for label, id := range existing {
+		if t.DeleteUnmanaged.ValueBool() || slices.Contains(labels, label) {
+			tagIDs[label] = int64(id)
+		}
+	}
+
+	managed := make([]string, 0, len(tagIDs))
+	for label := range tagIDs {
+		managed = append(managed, label)
+	}
+
+	t.Labels, tempDiag = types.SetValueFrom(ctx, types.StringType, managed)
+	diags.Append(tempDiag...)
+	t.TagIDs, tempDiag = types.MapValueFrom(ctx, types.Int64Type, tagIDs)
+	diags.Append(tempDiag...)
+
+	// Forget the created labels deleted outside of terraform
+	created := make([]string, 0)
+	diags.Append(t.CreatedLabels.ElementsAs(ctx, &created, false)...)
+	created = slices.DeleteFunc(created, func(label string) bool {
+		_, ok := existing[label]
+
+		return !ok
+	})
+	t.CreatedLabels, tempDiag = types.SetValueFrom(ctx, types.StringType, created)
+	diags.Append(tempDiag...)
+}
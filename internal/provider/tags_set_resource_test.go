@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTagsSetResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccTagsSetResourceConfig(`"set-a"`) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccTagsSetResourceConfig(`"set-a", "set-b"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_tags_set.test", "labels.#", "2"),
+					resource.TestCheckResourceAttrSet("sonarr_tags_set.test", "tag_ids.set-a"),
+					resource.TestCheckResourceAttrSet("sonarr_tags_set.test", "tag_ids.set-b"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccTagsSetResourceConfig(`"set-a"`) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTagsSetResourceConfig(`"set-b", "set-c"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_tags_set.test", "labels.#", "2"),
+					resource.TestCheckNoResourceAttr("sonarr_tags_set.test", "tag_ids.set-a"),
+					resource.TestCheckResourceAttrSet("sonarr_tags_set.test", "tag_ids.set-c"),
+					resource.TestCheckResourceAttr("sonarr_tags_set.test", "created_labels.#", "2"),
+					testAccCheckTagsExist(map[string]bool{"set-a": false, "set-b": true, "set-c": true}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sonarr_tags_set.test",
+				ImportState:             true,
+				ImportStateId:           "set-b,set-c",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_labels"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTagsSetResource_adopted(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adopt an existing tag
+			{
+				Config: testAccTagsSetResourceAdoptedConfig(`"set-created", sonarr_tag.adopted.label`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_tags_set.test", "tag_ids.set-adopted"),
+					resource.TestCheckResourceAttr("sonarr_tags_set.test", "created_labels.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sonarr_tags_set.test", "created_labels.*", "set-created"),
+				),
+			},
+			// Adopted tags removed from the set are left alone
+			{
+				Config: testAccTagsSetResourceAdoptedConfig(`"set-created"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("sonarr_tags_set.test", "tag_ids.set-adopted"),
+					testAccCheckTagsExist(map[string]bool{"set-adopted": true, "set-created": true}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckTagsExist checks through the API which labels exist in Sonarr.
+func testAccCheckTagsExist(expected map[string]bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		tags, _, err := testAccAPIClient().TagAPI.ListTag(context.Background()).Execute()
+		if err != nil {
+			return err
+		}
+
+		for label, exists := range expected {
+			found := slices.ContainsFunc(tags, func(t sonarr.TagResource) bool { return t.GetLabel() == label })
+			if found != exists {
+				return fmt.Errorf("expected tag %s to exist %t, got %t", label, exists, found)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTagsSetResourceConfig(labels string) string {
+	return fmt.Sprintf(`
+		resource "sonarr_tags_set" "test" {
+			labels = [%s]
+		}
+	`, labels)
+}
+
+func testAccTagsSetResourceAdoptedConfig(labels string) string {
+	return fmt.Sprintf(`
+		resource "sonarr_tag" "adopted" {
+			label = "set-adopted"
+		}
+
+		resource "sonarr_tags_set" "test" {
+			labels = [%s]
+
+			depends_on = [sonarr_tag.adopted]
+		}
+	`, labels)
+}
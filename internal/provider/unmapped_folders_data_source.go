@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const unmappedFoldersDataSourceName = "unmapped_folders"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UnmappedFoldersDataSource{}
+
+func NewUnmappedFoldersDataSource() datasource.DataSource {
+	return &UnmappedFoldersDataSource{}
+}
+
+// UnmappedFoldersDataSource defines the unmapped folders implementation.
+type UnmappedFoldersDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// UnmappedFolders describes the unmapped folders data model.
+type UnmappedFolders struct {
+	Folders      types.Set    `tfsdk:"folders"`
+	ID           types.String `tfsdk:"id"`
+	RootFolderID types.Int64  `tfsdk:"root_folder_id"`
+}
+
+func (d *UnmappedFoldersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + unmappedFoldersDataSourceName
+}
+
+func (d *UnmappedFoldersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Media Management -->\nList the folders of a [Root Folder](../resources/root_folder) not mapped to any series.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"root_folder_id": schema.Int64Attribute{
+				MarkdownDescription: "Root Folder ID.",
+				Required:            true,
+			},
+			"folders": schema.SetNestedAttribute{
+				MarkdownDescription: "Unmapped folders.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of unmapped folder.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of unmapped folder.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UnmappedFoldersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *UnmappedFoldersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *UnmappedFolders
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get unmapped folders current value
+	response, _, err := d.client.RootFolderAPI.GetRootFolderById(d.auth, int32(data.RootFolderID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, unmappedFoldersDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+unmappedFoldersDataSourceName)
+	// Map response body to resource schema attribute
+	folders := make([]Path, len(response.GetUnmappedFolders()))
+	for i, f := range response.GetUnmappedFolders() {
+		folders[i].write(&f)
+	}
+
+	folderList, diags := types.SetValueFrom(ctx, Path{}.getType(), folders)
+	resp.Diagnostics.Append(diags...)
+
+	data.Folders = folderList
+	data.ID = types.StringValue(strconv.Itoa(len(folders)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUnmappedFoldersDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccUnmappedFoldersDataSourceConfig("1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccUnmappedFoldersDataSourceRootFolderConfig + testAccUnmappedFoldersDataSourceConfig("data.sonarr_root_folder.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_unmapped_folders.test", "id"),
+					resource.TestCheckResourceAttrSet("data.sonarr_unmapped_folders.test", "folders.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUnmappedFoldersDataSourceConfig(id string) string {
+	return `
+	data "sonarr_unmapped_folders" "test" {
+		root_folder_id = ` + id + `
+	}`
+}
+
+const testAccUnmappedFoldersDataSourceRootFolderConfig = `
+data "sonarr_root_folder" "test" {
+	path = "/config"
+}
+`
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	wantedMissingDataSourceName = "wanted_missing"
+	wantedMissingPageSize       = 10
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WantedMissingDataSource{}
+
+func NewWantedMissingDataSource() datasource.DataSource {
+	return &WantedMissingDataSource{}
+}
+
+// WantedMissingDataSource defines the wanted missing implementation.
+type WantedMissingDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// WantedMissing describes the wanted missing data model.
+type WantedMissing struct {
+	Episodes     types.List   `tfsdk:"episodes"`
+	ID           types.String `tfsdk:"id"`
+	PageSize     types.Int64  `tfsdk:"page_size"`
+	TotalRecords types.Int64  `tfsdk:"total_records"`
+}
+
+// MissingEpisode is part of WantedMissing.
+type MissingEpisode struct {
+	SeriesTitle   types.String `tfsdk:"series_title"`
+	Title         types.String `tfsdk:"title"`
+	AirDate       types.String `tfsdk:"air_date"`
+	ID            types.Int64  `tfsdk:"id"`
+	SeriesID      types.Int64  `tfsdk:"series_id"`
+	SeasonNumber  types.Int64  `tfsdk:"season_number"`
+	EpisodeNumber types.Int64  `tfsdk:"episode_number"`
+}
+
+func (e MissingEpisode) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"id":             types.Int64Type,
+			"series_id":      types.Int64Type,
+			"season_number":  types.Int64Type,
+			"episode_number": types.Int64Type,
+			"series_title":   types.StringType,
+			"title":          types.StringType,
+			"air_date":       types.StringType,
+		})
+}
+
+func (d *WantedMissingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + wantedMissingDataSourceName
+}
+
+func (d *WantedMissingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nList the most recently aired monitored episodes without a file.\nFor more information refer to [Wanted](https://wiki.servarr.com/sonarr/wanted) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of episodes. Defaults to `%d`.", wantedMissingPageSize),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"total_records": schema.Int64Attribute{
+				MarkdownDescription: "Total number of missing episodes.",
+				Computed:            true,
+			},
+			"episodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Missing episodes sorted by air date descending.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Episode ID.",
+							Computed:            true,
+						},
+						"series_id": schema.Int64Attribute{
+							MarkdownDescription: "Series ID.",
+							Computed:            true,
+						},
+						"series_title": schema.StringAttribute{
+							MarkdownDescription: "Series title.",
+							Computed:            true,
+						},
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Season number.",
+							Computed:            true,
+						},
+						"episode_number": schema.Int64Attribute{
+							MarkdownDescription: "Episode number.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Episode title.",
+							Computed:            true,
+						},
+						"air_date": schema.StringAttribute{
+							MarkdownDescription: "Air date.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WantedMissingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *WantedMissingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *WantedMissing
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageSize := int32(wantedMissingPageSize)
+	if !data.PageSize.IsNull() {
+		pageSize = int32(data.PageSize.ValueInt64())
+	}
+
+	// Get wanted missing current value
+	response, _, err := d.client.MissingAPI.GetWantedMissing(d.auth).
+		PageSize(pageSize).
+		SortKey("episodes.airDateUtc").
+		SortDirection(sonarr.SORTDIRECTION_DESCENDING).
+		IncludeSeries(true).
+		Monitored(true).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, wantedMissingDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+wantedMissingDataSourceName)
+	// Map response body to resource schema attribute
+	episodes := make([]MissingEpisode, len(response.GetRecords()))
+	for i, e := range response.GetRecords() {
+		episodes[i].write(&e)
+	}
+
+	episodeList, diags := types.ListValueFrom(ctx, MissingEpisode{}.getType(), episodes)
+	resp.Diagnostics.Append(diags...)
+
+	data.Episodes = episodeList
+	data.TotalRecords = types.Int64Value(int64(response.GetTotalRecords()))
+	data.ID = types.StringValue(strconv.Itoa(len(episodes)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (e *MissingEpisode) write(episode *sonarr.EpisodeResource) {
+	series := episode.GetSeries()
+
+	e.ID = types.Int64Value(int64(episode.GetId()))
+	e.SeriesID = types.Int64Value(int64(episode.GetSeriesId()))
+	e.SeriesTitle = types.StringValue(series.GetTitle())
+	e.SeasonNumber = types.Int64Value(int64(episode.GetSeasonNumber()))
+	e.EpisodeNumber = types.Int64Value(int64(episode.GetEpisodeNumber()))
+	e.Title = types.StringValue(episode.GetTitle())
+	e.AirDate = types.StringValue(episode.GetAirDate())
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWantedMissingDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccWantedMissingDataSourceConfig(5) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccWantedMissingDataSourceConfig(5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_wanted_missing.test", "id"),
+					resource.TestCheckResourceAttrSet("data.sonarr_wanted_missing.test", "total_records"),
+					resource.TestCheckResourceAttrSet("data.sonarr_wanted_missing.test", "episodes.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWantedMissingDataSourceConfig(pageSize int) string {
+	return fmt.Sprintf(`
+	data "sonarr_wanted_missing" "test" {
+		page_size = %d
+	}`, pageSize)
+}
@@ -0,0 +1,350 @@
+// Package testutil provides helpers to test the provider, including a mock Sonarr instance.
+package testutil
+
+import (
+	"embed"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// MockAPIKey is the only API key accepted by the mock server.
+const MockAPIKey = "mock-api-key"
+
+const (
+	apiPrefix       = "/api/v3/"
+	schemaPath      = "schema"
+	actionPath      = "action"
+	maskedValue     = "********"
+	passwordPrivacy = "password"
+)
+
+// mockResources are the API resources served by the mock server, each one backed by testdata/<resource>.json.
+var mockResources = []string{"downloadclient", "indexer", "series", "qualityprofile", "rootfolder"}
+
+//go:embed testdata/*.json
+var fixtures embed.FS
+
+type item = map[string]any
+
+// mockServer is an in-memory implementation of a subset of the Sonarr v3 API.
+type mockServer struct {
+	items   map[string]map[int]item
+	schemas map[string][]item
+	nextID  map[string]int
+	mu      sync.Mutex
+}
+
+// MockSonarrServer starts an in-memory Sonarr API seeded with the JSON fixtures in testdata.
+// Items can be listed, created, read, updated and deleted, changes are kept in memory until cleanup is called.
+// Actions return the testdata/<resource>_action_<name>.json fixture.
+func MockSonarrServer(t *testing.T) (string, string, func()) {
+	t.Helper()
+
+	server, err := newMockServer()
+	if err != nil {
+		t.Fatalf("unable to load mock fixtures: %s", err)
+	}
+
+	ts := httptest.NewServer(server)
+
+	return ts.URL, MockAPIKey, ts.Close
+}
+
+func newMockServer() (*mockServer, error) {
+	server := &mockServer{
+		items:   make(map[string]map[int]item, len(mockResources)),
+		schemas: make(map[string][]item),
+		nextID:  make(map[string]int, len(mockResources)),
+	}
+
+	for _, resource := range mockResources {
+		var list []item
+		if err := loadFixture(resource+".json", &list); err != nil {
+			return nil, err
+		}
+
+		server.items[resource] = make(map[int]item, len(list))
+		server.nextID[resource] = 1
+
+		for _, i := range list {
+			id := getID(i)
+			server.items[resource][id] = i
+
+			if id >= server.nextID[resource] {
+				server.nextID[resource] = id + 1
+			}
+		}
+
+		var schemas []item
+		if err := loadFixture(resource+"_schema.json", &schemas); err == nil {
+			server.schemas[resource] = schemas
+		}
+	}
+
+	return server, nil
+}
+
+func loadFixture(name string, target any) error {
+	data, err := fixtures.ReadFile("testdata/" + name)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, target)
+}
+
+func (s *mockServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Api-Key") != MockAPIKey {
+		writeJSON(w, http.StatusUnauthorized, item{"message": "Unauthorized"})
+
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix), "/")
+	parts := strings.Split(path, "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items, ok := s.items[parts[0]]
+	if !ok || !strings.HasPrefix(r.URL.Path, apiPrefix) || len(parts) > 3 || (len(parts) == 3 && parts[1] != actionPath) {
+		writeJSON(w, http.StatusNotFound, item{"message": "NotFound"})
+
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		s.serveCollection(w, r, parts[0], items)
+	case len(parts) == 3:
+		s.serveAction(w, r, parts[0], parts[2])
+	case parts[1] == schemaPath && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.schemas[parts[0]])
+	default:
+		id, err := strconv.Atoi(parts[1])
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, item{"message": "invalid id " + parts[1]})
+
+			return
+		}
+
+		s.serveItem(w, r, id, items)
+	}
+}
+
+func (s *mockServer) serveCollection(w http.ResponseWriter, r *http.Request, resource string, items map[int]item) {
+	switch r.Method {
+	case http.MethodGet:
+		ids := make([]int, 0, len(items))
+		for id := range items {
+			ids = append(ids, id)
+		}
+
+		slices.Sort(ids)
+
+		list := make([]item, 0, len(ids))
+		for _, id := range ids {
+			list = append(list, mask(items[id]))
+		}
+
+		writeJSON(w, http.StatusOK, list)
+	case http.MethodPost:
+		var body item
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeJSON(w, http.StatusBadRequest, item{"message": err.Error()})
+
+			return
+		}
+
+		created := merge(s.template(resource, body), body)
+		created["id"] = s.nextID[resource]
+		s.nextID[resource]++
+		items[getID(created)] = created
+
+		writeJSON(w, http.StatusCreated, mask(created))
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, item{"message": "MethodNotAllowed"})
+	}
+}
+
+func (s *mockServer) serveItem(w http.ResponseWriter, r *http.Request, id int, items map[int]item) {
+	current, ok := items[id]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, item{"message": "NotFound"})
+
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, mask(current))
+	case http.MethodPut:
+		var body item
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeJSON(w, http.StatusBadRequest, item{"message": err.Error()})
+
+			return
+		}
+
+		updated := merge(current, body)
+		updated["id"] = id
+		items[id] = updated
+
+		writeJSON(w, http.StatusAccepted, mask(updated))
+	case http.MethodDelete:
+		delete(items, id)
+		writeJSON(w, http.StatusOK, item{})
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, item{"message": "MethodNotAllowed"})
+	}
+}
+
+// serveAction returns the testdata/<resource>_action_<name>.json fixture as the result of the action.
+func (s *mockServer) serveAction(w http.ResponseWriter, r *http.Request, resource, name string) {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, item{"message": "MethodNotAllowed"})
+
+		return
+	}
+
+	var result any
+	if err := loadFixture(resource+"_action_"+name+".json", &result); err != nil {
+		writeJSON(w, http.StatusNotFound, item{"message": "NotFound"})
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// template returns the schema matching the implementation of the given body, if any.
+func (s *mockServer) template(resource string, body item) item {
+	for _, schema := range s.schemas[resource] {
+		if schema["implementation"] == body["implementation"] {
+			return schema
+		}
+	}
+
+	return item{}
+}
+
+// merge returns a copy of base overridden by the values in body.
+// Fields are merged by name, so that fields missing in body keep their base value as Sonarr does.
+// Masked sensitive values are ignored to keep the stored secret.
+func merge(base, body item) item {
+	result := make(item, len(base)+len(body))
+	for k, v := range base {
+		result[k] = v
+	}
+
+	for k, v := range body {
+		if k != "fields" {
+			result[k] = v
+		}
+	}
+
+	baseFields := getFields(base)
+	fields := make([]any, 0, len(baseFields))
+	index := make(map[string]int, len(baseFields))
+
+	for _, f := range baseFields {
+		index[getName(f)] = len(fields)
+		fields = append(fields, copyItem(f))
+	}
+
+	for _, f := range getFields(body) {
+		if f["value"] == maskedValue {
+			continue
+		}
+
+		if i, ok := index[getName(f)]; ok {
+			fields[i].(item)["value"] = f["value"]
+
+			continue
+		}
+
+		fields = append(fields, copyItem(f))
+	}
+
+	if len(fields) > 0 || body["fields"] != nil {
+		result["fields"] = fields
+	}
+
+	return result
+}
+
+// mask returns a copy of the item with the non empty password fields masked, as returned by Sonarr.
+func mask(i item) item {
+	result := copyItem(i)
+
+	if _, ok := i["fields"]; !ok {
+		return result
+	}
+
+	fields := make([]any, 0)
+
+	for _, f := range getFields(i) {
+		field := copyItem(f)
+		if field["privacy"] == passwordPrivacy && field["value"] != "" && field["value"] != nil {
+			field["value"] = maskedValue
+		}
+
+		fields = append(fields, field)
+	}
+
+	result["fields"] = fields
+
+	return result
+}
+
+func copyItem(i item) item {
+	result := make(item, len(i))
+	for k, v := range i {
+		result[k] = v
+	}
+
+	return result
+}
+
+func getFields(i item) []item {
+	raw, _ := i["fields"].([]any)
+	fields := make([]item, 0, len(raw))
+
+	for _, f := range raw {
+		if field, ok := f.(item); ok {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+func getName(i item) string {
+	name, _ := i["name"].(string)
+
+	return name
+}
+
+func getID(i item) int {
+	switch id := i["id"].(type) {
+	case float64:
+		return int(id)
+	case int:
+		return id
+	default:
+		return 0
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,175 @@
+package testutil
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+)
+
+func mockClient(t *testing.T, key string) (context.Context, *sonarr.APIClient) {
+	t.Helper()
+
+	serverURL, _, cleanup := MockSonarrServer(t)
+	t.Cleanup(cleanup)
+
+	parsedURL, err := url.Parse(serverURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auth := context.WithValue(context.Background(), sonarr.ContextAPIKeys, map[string]sonarr.APIKey{
+		"X-Api-Key": {Key: key},
+	})
+	auth = context.WithValue(auth, sonarr.ContextServerVariables, map[string]string{
+		"protocol": parsedURL.Scheme,
+		"hostpath": parsedURL.Host,
+	})
+
+	return auth, sonarr.NewAPIClient(sonarr.NewConfiguration())
+}
+
+func testField(name string, value any) sonarr.Field {
+	field := sonarr.NewField()
+	field.SetName(name)
+	field.SetValue(value)
+
+	return *field
+}
+
+func fieldValue(fields []sonarr.Field, name string) any {
+	for _, f := range fields {
+		if f.GetName() == name {
+			return f.GetValue()
+		}
+	}
+
+	return nil
+}
+
+func TestMockSonarrServerUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	auth, client := mockClient(t, "wrong")
+
+	_, resp, err := client.DownloadClientAPI.ListDownloadClient(auth).Execute()
+	if err == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestMockSonarrServerFixtures(t *testing.T) {
+	t.Parallel()
+
+	auth, client := mockClient(t, MockAPIKey)
+
+	clients, _, err := client.DownloadClientAPI.ListDownloadClient(auth).Execute()
+	if err != nil || len(clients) != 1 || clients[0].GetName() != "Transmission" {
+		t.Fatalf("unexpected download clients %v: %v", clients, err)
+	}
+
+	indexers, _, err := client.IndexerAPI.ListIndexer(auth).Execute()
+	if err != nil || len(indexers) != 1 || indexers[0].GetImplementation() != "Newznab" {
+		t.Fatalf("unexpected indexers %v: %v", indexers, err)
+	}
+
+	series, _, err := client.SeriesAPI.GetSeriesById(auth, 1).Execute()
+	if err != nil || series.GetTitle() != "Breaking Bad" {
+		t.Fatalf("unexpected series %v: %v", series, err)
+	}
+
+	profiles, _, err := client.QualityProfileAPI.ListQualityProfile(auth).Execute()
+	if err != nil || len(profiles) != 1 || profiles[0].GetName() != "Any" {
+		t.Fatalf("unexpected quality profiles %v: %v", profiles, err)
+	}
+
+	folders, _, err := client.RootFolderAPI.ListRootFolder(auth).Execute()
+	if err != nil || len(folders) != 2 || folders[1].GetPath() != "D:\\tv" {
+		t.Fatalf("unexpected root folders %v: %v", folders, err)
+	}
+}
+
+func TestMockSonarrServerCRUD(t *testing.T) {
+	t.Parallel()
+
+	auth, client := mockClient(t, MockAPIKey)
+
+	request := sonarr.NewDownloadClientResource()
+	request.SetName("Download Station")
+	request.SetImplementation("TorrentDownloadStation")
+	request.SetConfigContract("DownloadStationSettings")
+	request.SetFields([]sonarr.Field{testField("host", "download-station"), testField("password", "secret")})
+
+	created, _, err := client.DownloadClientAPI.CreateDownloadClient(auth).DownloadClientResource(*request).Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created.GetId() != 2 || created.GetProtocol() != sonarr.DOWNLOADPROTOCOL_TORRENT {
+		t.Fatalf("unexpected created download client %v", created)
+	}
+
+	if fieldValue(created.GetFields(), "host") != "download-station" || fieldValue(created.GetFields(), "port") != float64(5000) {
+		t.Fatalf("fields not merged with schema: %v", created.GetFields())
+	}
+
+	if fieldValue(created.GetFields(), "password") != maskedValue {
+		t.Fatalf("password not masked: %v", fieldValue(created.GetFields(), "password"))
+	}
+
+	created.SetFields([]sonarr.Field{testField("port", 5001), testField("password", maskedValue)})
+
+	updated, _, err := client.DownloadClientAPI.UpdateDownloadClient(auth, "2").DownloadClientResource(*created).Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fieldValue(updated.GetFields(), "port") != float64(5001) || fieldValue(updated.GetFields(), "host") != "download-station" {
+		t.Fatalf("unexpected updated fields: %v", updated.GetFields())
+	}
+
+	read, _, err := client.DownloadClientAPI.GetDownloadClientById(auth, 2).Execute()
+	if err != nil || fieldValue(read.GetFields(), "port") != float64(5001) {
+		t.Fatalf("unexpected read download client %v: %v", read, err)
+	}
+
+	if _, err = client.DownloadClientAPI.DeleteDownloadClient(auth, 2).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, resp, err := client.DownloadClientAPI.GetDownloadClientById(auth, 2).Execute()
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestMockSonarrServerSchema(t *testing.T) {
+	t.Parallel()
+
+	auth, client := mockClient(t, MockAPIKey)
+
+	schemas, _, err := client.DownloadClientAPI.ListDownloadClientSchema(auth).Execute()
+	if err != nil || len(schemas) != 3 {
+		t.Fatalf("unexpected download client schemas %v: %v", schemas, err)
+	}
+}
+
+func TestMockSonarrServerAction(t *testing.T) {
+	t.Parallel()
+
+	auth, client := mockClient(t, MockAPIKey)
+
+	resp, err := client.IndexerAPI.CreateIndexerActionByName(auth, "newznabCategories").IndexerResource(*sonarr.NewIndexerResource()).Execute()
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected action response: %v", err)
+	}
+
+	resp.Body.Close()
+
+	resp, err = client.IndexerAPI.CreateIndexerActionByName(auth, "unknown").IndexerResource(*sonarr.NewIndexerResource()).Execute()
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
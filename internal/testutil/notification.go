@@ -0,0 +1,94 @@
+package testutil
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// notificationEvents are the event flags shared by all the notification implementations, disabled by default.
+var notificationEvents = map[string]string{
+	"on_grab":                            "false",
+	"on_download":                        "false",
+	"on_upgrade":                         "false",
+	"on_series_delete":                   "false",
+	"on_episode_file_delete":             "false",
+	"on_episode_file_delete_for_upgrade": "false",
+	"on_health_issue":                    "false",
+	"on_application_update":              "false",
+	"include_health_warnings":            "false",
+}
+
+// notificationDefaults are the attributes needed for a valid configuration of each implementation.
+var notificationDefaults = map[string]map[string]string{
+	"Slack": {
+		"on_rename":    "false",
+		"web_hook_url": `"http://my.slack.com/test"`,
+		"username":     `"user"`,
+	},
+	"Discord": {
+		"on_rename":    "false",
+		"web_hook_url": `"http://discord-web-hook.com"`,
+		"username":     `"User"`,
+	},
+	"Webhook": {
+		"on_rename": "false",
+		"url":       `"http://transmission:9091"`,
+		"method":    "1",
+	},
+	"Email": {
+		"server": `"http://email-server.net"`,
+		"port":   "587",
+		"from":   `"test@email.com"`,
+		"to":     `["test@test.com"]`,
+	},
+}
+
+// NotificationTestConfig returns the HCL of a sonarr_notification_<implementation> "test" resource named name.
+// Values of extraAttrs are HCL expressions, they are merged over the defaults of the implementation.
+// Implementations without defaults only get the shared event flags.
+func NotificationTestConfig(name, implementation string, extraAttrs map[string]string) string {
+	attrs := maps.Clone(notificationEvents)
+	maps.Copy(attrs, notificationDefaults[implementation])
+	maps.Copy(attrs, extraAttrs)
+	attrs["name"] = fmt.Sprintf("%q", name)
+
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	var config strings.Builder
+
+	fmt.Fprintf(&config, "\n\tresource \"sonarr_notification_%s\" \"test\" {\n", strings.ToLower(implementation))
+
+	for _, key := range keys {
+		fmt.Fprintf(&config, "\t\t%s = %s\n", key, attrs[key])
+	}
+
+	config.WriteString("\t}\n")
+
+	return config.String()
+}
+
+// CheckNotificationExists verifies that the notification resourceName is in the state with an ID.
+func CheckNotificationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("notification %s not found in state", resourceName)
+		}
+
+		if rs.Primary == nil || rs.Primary.ID == "" || rs.Primary.ID == "0" {
+			return fmt.Errorf("notification %s has no ID in state", resourceName)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,89 @@
+package testutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestNotificationTestConfig(t *testing.T) {
+	t.Parallel()
+
+	config := NotificationTestConfig("slackTest", "Slack", map[string]string{
+		"username": `"override"`,
+		"channel":  `"general"`,
+	})
+
+	for _, expected := range []string{
+		`resource "sonarr_notification_slack" "test" {`,
+		`name = "slackTest"`,
+		`on_grab = false`,
+		`on_rename = false`,
+		`web_hook_url = "http://my.slack.com/test"`,
+		`username = "override"`,
+		`channel = "general"`,
+	} {
+		if !strings.Contains(config, expected) {
+			t.Errorf("expected %q in config:\n%s", expected, config)
+		}
+	}
+
+	if strings.Contains(config, `username = "user"`) {
+		t.Errorf("expected username default to be overridden:\n%s", config)
+	}
+
+	if config != NotificationTestConfig("slackTest", "Slack", map[string]string{"channel": `"general"`, "username": `"override"`}) {
+		t.Error("expected config to be stable")
+	}
+}
+
+func TestNotificationTestConfigUnknownImplementation(t *testing.T) {
+	t.Parallel()
+
+	config := NotificationTestConfig("gotifyTest", "Gotify", nil)
+
+	if !strings.Contains(config, `resource "sonarr_notification_gotify" "test" {`) || !strings.Contains(config, "on_grab = false") {
+		t.Errorf("expected shared attributes only:\n%s", config)
+	}
+
+	if strings.Contains(config, "on_rename") {
+		t.Errorf("unexpected implementation attribute:\n%s", config)
+	}
+}
+
+func TestCheckNotificationExists(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		id      string
+		missing bool
+		err     bool
+	}{
+		"exists":  {id: "1"},
+		"no id":   {id: "", err: true},
+		"zero id": {id: "0", err: true},
+		"missing": {missing: true, err: true},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := terraform.NewState()
+			if !test.missing {
+				state.RootModule().Resources["sonarr_notification_slack.test"] = &terraform.ResourceState{
+					Type:    "sonarr_notification_slack",
+					Primary: &terraform.InstanceState{ID: test.id},
+				}
+			}
+
+			err := CheckNotificationExists("sonarr_notification_slack.test")(state)
+			if (err != nil) != test.err {
+				t.Errorf("expected error %t, got %v", test.err, err)
+			}
+		})
+	}
+}